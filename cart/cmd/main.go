@@ -49,6 +49,13 @@ func main() {
 		}
 	}()
 
+	go func() {
+		adminServerErr := cartApp.ListenAndServeAdmin()
+		if adminServerErr != nil && adminServerErr != http.ErrServerClosed {
+			panic(adminServerErr)
+		}
+	}()
+
 	gracefulTimeout, err := time.ParseDuration(cartApp.Config.Server.GracefulShutdownTimeout)
 	if err != nil {
 		gracefulTimeout = defaultGracefulShutdownTimeout
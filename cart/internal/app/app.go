@@ -2,39 +2,56 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	_ "net/http/pprof" // nolint:gosec // profiling enabled for local debugging
 	"time"
 
+	"route256/cart/internal/domain"
 	"route256/cart/internal/handler"
 	"route256/cart/internal/infra/config"
+	"route256/cart/internal/infra/currency"
 	"route256/cart/internal/infra/http/middleware"
 	"route256/cart/internal/infra/http/roundtripper"
+	"route256/cart/internal/infra/kafka"
 	"route256/cart/internal/infra/metrics"
 	"route256/cart/internal/infra/ratelimit"
 	"route256/cart/internal/infra/repository"
 	"route256/cart/internal/service"
+	"route256/cart/pkg/circuitbreaker"
 	mwpkg "route256/cart/pkg/http/middleware"
 	"route256/cart/pkg/logger"
 	"route256/cart/pkg/myerrgroup"
+	"route256/cart/pkg/postgres"
 	"route256/cart/pkg/tracer"
 	"route256/loms/pkg/api/orders/v1"
 	"route256/loms/pkg/api/stocks/v1"
 	"route256/loms/pkg/grpc/interceptor"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+// stockInfoMethod полное имя gRPC-метода получения остатков в loms.
+const stockInfoMethod = "/StockServiceV1/StockInfoV1"
+
 // App создает компоненты для сервиса cart
 type App struct {
 	Config        *config.Config
 	server        http.Server
+	adminServer   http.Server
 	repoObserver  *metrics.RepositoryObserver
 	tracerManager *tracer.Manager
+	expiryWorker  *service.CartExpiryWorker
+	abandonedPub  *kafka.AbandonedCartTopicKafka
+	// pool соединения с БД, создается только для backend rate limiter postgres.
+	pool *pgxpool.Pool
 }
 
 // NewApp конструктор главного приложения.
@@ -45,19 +62,25 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	}
 
 	a := &App{Config: c}
-	a.tracerManager, err = tracer.NewTracerManager(
-		ctx,
-		fmt.Sprintf("http://%s:%s", a.Config.Jaeger.Host, a.Config.Jaeger.Port),
-		a.Config.Server.Tracing.ServiceName,
-		a.Config.Server.Tracing.Environment,
-	)
+	tracingConfig := a.Config.Server.Tracing
+	a.tracerManager, err = tracer.NewTracerManager(ctx, tracer.Config{
+		ServiceName:  tracingConfig.ServiceName,
+		Environment:  tracingConfig.Environment,
+		Exporter:     tracingConfig.Exporter,
+		Endpoint:     fmt.Sprintf("http://%s:%s", a.Config.Jaeger.Host, a.Config.Jaeger.Port),
+		SampleRatio:  *tracingConfig.SampleRatio,
+		ParentBased:  tracingConfig.ParentBased,
+		SampleErrors: tracingConfig.SampleErrors,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("tracer.NewTracerManager: %w", err)
 	}
 
-	a.server.Handler, err = a.bootstrapHandlers()
+	a.server.Handler, err = a.bootstrapHandlers(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("app.bootstrapHandlers: %w", err)
+		err = errors.Join(fmt.Errorf("app.bootstrapHandlers: %w", err), a.stopCartExpiryWorker())
+		a.closePool()
+		return nil, err
 	}
 
 	return a, nil
@@ -77,31 +100,127 @@ func (a *App) ListenAndServe() error {
 	return a.server.Serve(l)
 }
 
-func (a *App) bootstrapHandlers() (http.Handler, error) {
+// ListenAndServeAdmin запускает внутренний HTTP-сервер с административными ручками.
+func (a *App) ListenAndServeAdmin() error {
+	address := fmt.Sprintf("%s:%s", a.Config.Server.Admin.Host, a.Config.Server.Admin.Port)
+
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("net.Listen: %w", err)
+	}
+
+	logger.Infow(fmt.Sprintf("Cart admin listening at http://%s", address))
+
+	return a.adminServer.Serve(l)
+}
+
+func (a *App) bootstrapHandlers(ctx context.Context) (http.Handler, error) {
+	productConfig := a.Config.ProductService
+	productRetryBaseBackoff, err := time.ParseDuration(productConfig.Retry.BaseBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	productRetryMaxBackoff, err := time.ParseDuration(productConfig.Retry.MaxBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	productBreakerOpenTimeout, err := time.ParseDuration(productConfig.CircuitBreaker.OpenTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	retryOpts := []roundtripper.RetryOption{
+		roundtripper.WithBackoff(productRetryBaseBackoff, productRetryMaxBackoff),
+		roundtripper.WithCircuitBreaker(circuitbreaker.New("product", circuitbreaker.Config{
+			FailureThreshold:    productConfig.CircuitBreaker.FailureThreshold,
+			OpenTimeout:         productBreakerOpenTimeout,
+			HalfOpenMaxRequests: productConfig.CircuitBreaker.HalfOpenMaxRequests,
+		})),
+	}
+	if productConfig.Retry.OnError {
+		retryOpts = append(retryOpts, roundtripper.WithRetryOnError())
+	}
+	if productConfig.Retry.OnServerError {
+		retryOpts = append(retryOpts, roundtripper.WithRetryOnServerError())
+	}
+
 	transport := http.DefaultTransport
 	transport = roundtripper.NewMetricsRoundTripper(transport)
-	transport = roundtripper.NewRetryRoundTripper(transport, []int{420, 429}, 3)
+	transport = roundtripper.NewRetryRoundTripper(transport, productConfig.Retry.Statuses, productConfig.Retry.MaxRetries, retryOpts...)
 	httpClient := &http.Client{
 		Transport: transport,
 		Timeout:   10 * time.Second,
 	}
-	rps := a.Config.ProductService.Limit
-	interval := time.Second / time.Duration(rps)
-	rateLimiter := ratelimit.NewPoolRateLimiter(rps, interval)
+	rateLimitBackend, err := a.newRateLimitBackend(ctx, productConfig)
+	if err != nil {
+		return nil, fmt.Errorf("app.newRateLimitBackend: %w", err)
+	}
+	rateLimiter := ratelimit.NewTokenBucketRateLimiter("product", rateLimitBackend)
 
-	productService := service.NewProductServiceHTTP(
+	productServiceHTTP := service.NewProductServiceHTTP(
 		httpClient,
 		rateLimiter,
 		a.Config.ProductService.Token,
 		fmt.Sprintf("%s://%s:%s", a.Config.ProductService.Protocol, a.Config.ProductService.Host, a.Config.ProductService.Port),
+		service.ProductBatchConfig{
+			Path:         productConfig.Batch.Path,
+			MaxBatchSize: productConfig.Batch.MaxBatchSize,
+			MaxFanOut:    productConfig.Batch.MaxFanOut,
+		},
 	)
 
+	productCacheTTL, err := time.ParseDuration(productConfig.Cache.TTL)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	productCacheNegativeTTL, err := time.ParseDuration(productConfig.Cache.NegativeTTL)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	productCacheLoadTimeout, err := parseOptionalDuration(productConfig.Cache.LoadTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("parseOptionalDuration: %w", err)
+	}
+	productService := service.NewCachedProductService(productServiceHTTP, service.ProductCacheConfig{
+		Capacity:    productConfig.Cache.Capacity,
+		TTL:         productCacheTTL,
+		NegativeTTL: productCacheNegativeTTL,
+		LoadTimeout: productCacheLoadTimeout,
+	})
+
+	lomsConfig := a.Config.LomsService
+	lomsTimeout, err := time.ParseDuration(lomsConfig.Timeout)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	lomsRetryBackoff, err := time.ParseDuration(lomsConfig.RetryBackoff)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	lomsBreakerOpenTimeout, err := time.ParseDuration(lomsConfig.CircuitBreaker.OpenTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	lomsBreaker := circuitbreaker.New("loms", circuitbreaker.Config{
+		FailureThreshold:    lomsConfig.CircuitBreaker.FailureThreshold,
+		OpenTimeout:         lomsBreakerOpenTimeout,
+		HalfOpenMaxRequests: lomsConfig.CircuitBreaker.HalfOpenMaxRequests,
+	})
+
 	conn, err := grpc.NewClient(
-		fmt.Sprintf("%s:%s", a.Config.LomsService.Host, a.Config.LomsService.Port),
+		fmt.Sprintf("%s:%s", lomsConfig.Host, lomsConfig.Port),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithChainUnaryInterceptor(
 			interceptor.ClientTracing,
 			interceptor.ClientMetrics,
+			interceptor.NewClientCircuitBreaker(lomsBreaker).Do,
+			// Получение остатков идемпотентно, поэтому повторяется и при превышении дедлайна или перегрузке loms.
+			interceptor.NewClientRetry(lomsConfig.MaxRetries, lomsRetryBackoff,
+				interceptor.WithMethodRetryCodes(stockInfoMethod,
+					codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted),
+			).Do,
+			// Дедлайн задается на каждую попытку, чтобы превышение дедлайна можно было повторить.
+			interceptor.NewClientDeadline(lomsTimeout).Do,
 		),
 	)
 	if err != nil {
@@ -114,9 +233,26 @@ func (a *App) bootstrapHandlers() (http.Handler, error) {
 	const cartsStorageCap = 100
 	cartRepository := repository.NewInMemoryCartRepository(cartsStorageCap)
 
-	cartService := service.NewCartService(cartRepository, productService, lomsService)
+	sessionRepository := repository.NewInMemorySessionRepository()
+
+	err = a.startCartExpiryWorker(ctx, cartRepository, sessionRepository)
+	if err != nil {
+		return nil, fmt.Errorf("app.startCartExpiryWorker: %w", err)
+	}
+
+	promotions, err := newPromotions(a.Config.Promotions)
+	if err != nil {
+		return nil, fmt.Errorf("newPromotions: %w", err)
+	}
+	promoRepository := repository.NewInMemoryPromoRepository(promotions)
+
+	cartServiceOpts, err := newCartServiceOptions(a.Config.Currency, a.Config.GuestCart)
+	if err != nil {
+		return nil, fmt.Errorf("newCartServiceOptions: %w", err)
+	}
+	cartService := service.NewCartService(cartRepository, productService, lomsService, promoRepository, cartServiceOpts...)
 
-	s := handler.NewServer(cartService, lomsService)
+	s := handler.NewServer(cartService, lomsService, sessionRepository)
 
 	a.repoObserver = metrics.NewRepositoryObserver([]*metrics.RepositoryInfo{
 		{Repo: cartRepository, ObjectName: "cart"},
@@ -128,6 +264,18 @@ func (a *App) bootstrapHandlers() (http.Handler, error) {
 	mx.HandleFunc("DELETE /user/{user_id}/cart/{sku_id}", s.DeleteCartItemHandler)
 	mx.HandleFunc("DELETE /user/{user_id}/cart", s.ClearCartHandler)
 	mx.HandleFunc("GET /user/{user_id}/cart", s.GetCartHandler)
+	mx.HandleFunc("POST /user/{user_id}/cart/promo", s.ApplyPromoCodeHandler)
+	mx.HandleFunc("DELETE /user/{user_id}/cart/promo", s.RemovePromoCodeHandler)
+	mx.HandleFunc("POST /user/{user_id}/cart/{sku_id}/save", s.SaveCartItemHandler)
+	mx.HandleFunc("GET /user/{user_id}/saved", s.GetSavedItemsHandler)
+	mx.HandleFunc("POST /user/{user_id}/saved/{sku_id}/move-to-cart", s.MoveSavedItemToCartHandler)
+	mx.HandleFunc("DELETE /user/{user_id}/saved/{sku_id}", s.DeleteSavedItemHandler)
+	mx.HandleFunc("POST /user/{user_id}/cart/merge", s.MergeCartHandler)
+	mx.HandleFunc("POST /guest/session", s.CreateGuestSessionHandler)
+	mx.HandleFunc("POST /guest/cart/{sku_id}", s.AddGuestCartItemHandler)
+	mx.HandleFunc("DELETE /guest/cart/{sku_id}", s.DeleteGuestCartItemHandler)
+	mx.HandleFunc("GET /guest/cart", s.GetGuestCartHandler)
+	mx.HandleFunc("DELETE /guest/cart", s.ClearGuestCartHandler)
 	mx.HandleFunc("POST /checkout/{user_id}", s.CheckoutCartHandler)
 
 	mx.HandleFunc("/debug/pprof/", http.DefaultServeMux.ServeHTTP)
@@ -137,9 +285,210 @@ func (a *App) bootstrapHandlers() (http.Handler, error) {
 	h = mwpkg.NewMetricsMiddleware(h)
 	h = mwpkg.NewTracing(h, a.tracerManager)
 
+	a.adminServer.Handler = newAdminHandler(rateLimiter)
+
 	return h, nil
 }
 
+// newAdminHandler создает обработчик административных ручек.
+// Ручки не требуют авторизации, поэтому обслуживаются только на внутреннем адресе, а не на публичном.
+func newAdminHandler(rateLimiter handler.LimitSetter) http.Handler {
+	mx := http.NewServeMux()
+	mx.HandleFunc("PUT /admin/rate-limit/product", handler.NewRateLimitHandler(rateLimiter).SetRateLimitHandler)
+
+	return middleware.NewLoggerMiddleware(mx)
+}
+
+// startCartExpiryWorker запускает вытеснение неактивных корзин и гостевых сессий
+// и публикацию событий о брошенных корзинах в kafka.
+func (a *App) startCartExpiryWorker(
+	ctx context.Context,
+	cartRepository service.CartExpiryRepository,
+	sessionRepository service.SessionExpiryRepository,
+) error {
+	expiryConfig := a.Config.CartExpiry
+	ttl, err := parseOptionalDuration(expiryConfig.TTL)
+	if err != nil {
+		return fmt.Errorf("parseOptionalDuration: %w", err)
+	}
+	abandonAfter, err := parseOptionalDuration(expiryConfig.AbandonAfter)
+	if err != nil {
+		return fmt.Errorf("parseOptionalDuration: %w", err)
+	}
+	if ttl == 0 && abandonAfter == 0 {
+		return nil
+	}
+	if ttl > 0 && abandonAfter >= ttl {
+		return fmt.Errorf("cart expiry abandon_after %s must be less than ttl %s", abandonAfter, ttl)
+	}
+
+	period, err := time.ParseDuration(expiryConfig.Period)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	if period <= 0 {
+		return fmt.Errorf("cart expiry period must be positive: %s", period)
+	}
+
+	var pub service.AbandonedCartPublisher
+	if abandonAfter > 0 {
+		a.abandonedPub, err = kafka.NewAbandonedCartTopicKafka([]string{a.Config.Kafka.Brokers}, a.Config.Kafka.AbandonedCartTopic, a.tracerManager)
+		if err != nil {
+			return fmt.Errorf("kafka.NewAbandonedCartTopicKafka: %w", err)
+		}
+		pub = a.abandonedPub
+	}
+
+	a.expiryWorker = service.NewCartExpiryWorker(cartRepository, sessionRepository, pub, service.CartExpiryConfig{
+		TTL:          ttl,
+		AbandonAfter: abandonAfter,
+		Period:       period,
+		BatchSize:    expiryConfig.BatchSize,
+	})
+	a.expiryWorker.Start(ctx)
+
+	return nil
+}
+
+// stopCartExpiryWorker останавливает вытеснение корзин и только после этого закрывает продюсер,
+// чтобы текущая итерация не отправляла события в закрытый продюсер.
+func (a *App) stopCartExpiryWorker() error {
+	if a.expiryWorker != nil {
+		a.expiryWorker.Stop()
+	}
+	if a.abandonedPub != nil {
+		err := a.abandonedPub.Close()
+		if err != nil {
+			return fmt.Errorf("abandonedPub.Close: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// parseOptionalDuration разбирает длительность, пустая строка означает 0.
+func parseOptionalDuration(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	return time.ParseDuration(value)
+}
+
+// newRateLimitBackend создает хранилище состояния rate limiter сервиса product.
+// Для backend postgres создается пул соединений с БД.
+func (a *App) newRateLimitBackend(ctx context.Context, productConfig config.ProductServiceConfig) (ratelimit.Backend, error) {
+	switch productConfig.RateLimit.Backend {
+	case "", "local":
+		return ratelimit.NewLocalBackend(productConfig.Limit, productConfig.Burst)
+	case "postgres":
+		dbConfig := a.Config.DB
+		pool, err := newPool(ctx, dsnBuilder(dbConfig.User, dbConfig.Password, dbConfig.Host, dbConfig.Port, dbConfig.DBName),
+			postgres.NewQueryTracer(a.tracerManager.Tracer, "main"))
+		if err != nil {
+			return nil, fmt.Errorf("newPool: %w", err)
+		}
+		a.pool = pool
+
+		return ratelimit.NewPostgresBackend(ctx, pool, "product", productConfig.Limit, productConfig.Burst)
+	default:
+		return nil, fmt.Errorf("unknown rate limit backend: %s", productConfig.RateLimit.Backend)
+	}
+}
+
+func dsnBuilder(user, password, host string, port int64, dbname string) string {
+	return fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", user, password, host, port, dbname)
+}
+
+func newPool(ctx context.Context, dsn string, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.ParseConfig (dsn=%s): %w", dsn, err)
+	}
+
+	config.ConnConfig.Tracer = tracer
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.NewWithConfig (dsn=%s): %w", dsn, err)
+	}
+
+	return pool, nil
+}
+
+func (a *App) closePool() {
+	if a.pool != nil {
+		a.pool.Close()
+	}
+}
+
+func newCartServiceOptions(currencyConfig config.CurrencyConfig, guestConfig config.GuestCartConfig) ([]service.CartServiceOption, error) {
+	var opts []service.CartServiceOption
+
+	switch currencyConfig.Mode {
+	case "", "reject":
+	case "convert":
+		rates, err := currency.NewStaticRateProvider(currencyConfig.RatesFile)
+		if err != nil {
+			return nil, fmt.Errorf("currency.NewStaticRateProvider: %w", err)
+		}
+
+		base := currencyConfig.Base
+		if base == "" {
+			base = domain.DefaultCurrency
+		}
+
+		opts = append(opts, service.WithCurrencyConversion(base, rates))
+	default:
+		return nil, fmt.Errorf("unknown currency mode: %s", currencyConfig.Mode)
+	}
+
+	if guestConfig.MergePolicy != "" {
+		policy := domain.MergePolicy(guestConfig.MergePolicy)
+		if !policy.IsValid() {
+			return nil, fmt.Errorf("unknown guest cart merge policy: %s", guestConfig.MergePolicy)
+		}
+
+		opts = append(opts, service.WithMergePolicy(policy))
+	}
+
+	return opts, nil
+}
+
+func newPromotions(promotionsConfig []config.PromotionConfig) ([]*domain.Promotion, error) {
+	promotions := make([]*domain.Promotion, 0, len(promotionsConfig))
+	for _, promoConfig := range promotionsConfig {
+		promo := &domain.Promotion{
+			Code:        promoConfig.Code,
+			Description: promoConfig.Description,
+			Rules:       make([]domain.PromoRule, 0, len(promoConfig.Rules)),
+		}
+
+		for _, ruleConfig := range promoConfig.Rules {
+			kind := domain.PromoRuleKind(ruleConfig.Kind)
+			switch kind {
+			case domain.PromoPercentage, domain.PromoFixedAmount, domain.PromoBuyNGetM, domain.PromoMinTotal:
+			default:
+				return nil, fmt.Errorf("unknown promo rule kind %q in promo %s", ruleConfig.Kind, promoConfig.Code)
+			}
+
+			promo.Rules = append(promo.Rules, domain.PromoRule{
+				Kind:     kind,
+				Percent:  ruleConfig.Percent,
+				Amount:   ruleConfig.Amount,
+				Sku:      ruleConfig.Sku,
+				Buy:      ruleConfig.Buy,
+				Free:     ruleConfig.Free,
+				MinTotal: ruleConfig.MinTotal,
+			})
+		}
+
+		promotions = append(promotions, promo)
+	}
+
+	return promotions, nil
+}
+
 // Shutdown gracefully останавливает приложение.
 func (a *App) Shutdown(ctx context.Context) error {
 	a.repoObserver.Stop()
@@ -153,5 +502,15 @@ func (a *App) Shutdown(ctx context.Context) error {
 		return a.server.Shutdown(ctx)
 	})
 
-	return errGroup.Wait()
+	errGroup.Go(func() error {
+		return a.adminServer.Shutdown(ctx)
+	})
+
+	errGroup.Go(a.stopCartExpiryWorker)
+
+	err := errGroup.Wait()
+	// Пул закрывается после остановки серверов: обрабатываемые запросы еще обращаются к rate limiter.
+	a.closePool()
+
+	return err
 }
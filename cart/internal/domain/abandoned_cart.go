@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// AbandonedCartEvent событие о брошенной корзине: пользователь не изменял непустую корзину дольше заданного времени.
+type AbandonedCartEvent struct {
+	// EventID уникальный идентификатор события (UUID), не повторяется после перезапуска сервиса.
+	EventID string
+	UserID  int64
+	Items   []*CartItem
+	// UpdatedAt время последнего изменения корзины.
+	UpdatedAt time.Time
+}
@@ -1,7 +1,40 @@
 package domain
 
+import "time"
+
 // Cart хранит данные о корзине.
 type Cart struct {
-	Items      []*CartItem
-	TotalPrice uint32
+	Items []*CartItem
+	// Subtotal сумма товаров без учета скидок.
+	Subtotal Money
+	// TotalPrice итоговая сумма корзины с учетом скидок.
+	TotalPrice Money
+	// PromoCode промокод, примененный к корзине.
+	PromoCode string
+	// Discounts скидки по промокоду. Пусто, если промокод не применен или не подходит к корзине.
+	Discounts []Discount
+	// Partial признак того, что данные о части товаров неактуальны или недоступны, потому что недоступен
+	// сервис product. TotalPrice в этом случае учитывает только товары с известной ценой.
+	Partial bool
+	// PriceChanged признак того, что цена хотя бы одного товара изменилась с момента добавления в корзину.
+	PriceChanged bool
+	// Totals суммы товаров без учета скидок отдельно по каждой валюте, отсортированные по коду валюты.
+	Totals []Money
+	// MixedCurrencies признак того, что в корзине товары в разных валютах, а пересчет валют выключен.
+	// Subtotal и TotalPrice в этом случае не считаются, промокод не применяется.
+	MixedCurrencies bool
+	// UpdatedAt время последнего изменения корзины пользователем.
+	UpdatedAt time.Time
+}
+
+// UnavailableSkus возвращает SKU товаров корзины, данных о которых нет.
+func (c *Cart) UnavailableSkus() []int64 {
+	var skus []int64
+	for _, item := range c.Items {
+		if item.Availability == ItemUnavailable {
+			skus = append(skus, item.Sku)
+		}
+	}
+
+	return skus
 }
@@ -1,9 +1,41 @@
 package domain
 
+// ItemAvailability показывает, насколько актуальны данные о товаре в корзине.
+type ItemAvailability int
+
+const (
+	// ItemAvailable данные о товаре получены из сервиса product.
+	ItemAvailable ItemAvailability = iota
+	// ItemStale сервис product недоступен, данные о товаре взяты из последнего известного состояния.
+	ItemStale
+	// ItemUnavailable данных о товаре нет: товар удален из сервиса product или сервис недоступен
+	// и последнее известное состояние товара не сохранилось.
+	ItemUnavailable
+)
+
+// String возвращает название состояния.
+func (a ItemAvailability) String() string {
+	switch a {
+	case ItemAvailable:
+		return "available"
+	case ItemStale:
+		return "stale"
+	case ItemUnavailable:
+		return "unavailable"
+	default:
+		return "unknown"
+	}
+}
+
 // CartItem хранит данные о товаре в корзине.
 type CartItem struct {
-	Sku   int64
-	Name  string
-	Count uint32
-	Price uint32
+	Sku          int64
+	Name         string
+	Count        uint32
+	Price        Money
+	Availability ItemAvailability
+	// AddedPrice цена товара на момент добавления в корзину.
+	AddedPrice Money
+	// PriceChanged признак того, что текущая цена отличается от цены на момент добавления.
+	PriceChanged bool
 }
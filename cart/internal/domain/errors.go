@@ -1,12 +1,63 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 var ErrCartNotFound = errors.New("у пользователя пустая корзина")
+var ErrCartItemNotFound = errors.New("товара нет в корзине")
+var ErrSavedItemNotFound = errors.New("товара нет в списке отложенных")
 var ErrProductNotFound = errors.New("SKU не существует")
 
 var ErrSKUNotValid = errors.New("SKU должен быть натуральным числом (больше нуля)")
 var ErrUserIDNotValid = errors.New("идентификатор пользователя должен быть натуральным числом (больше нуля)")
 var ErrCountNotValid = errors.New("количество должно быть натуральным числом (больше нуля)")
+var ErrRequestBodyNotValid = errors.New("некорректное тело запроса")
 
 var ErrOutOfStock = errors.New("невозможно добавить товара по количеству больше, чем есть в стоках")
+
+var ErrStockNotFound = errors.New("нет информации о запасах товара")
+var ErrNotEnoughStock = errors.New("недостаточно товара для оформления заказа")
+var ErrLomsUnavailable = errors.New("сервис заказов временно недоступен")
+
+var ErrRateLimitNotValid = errors.New("лимит запросов должен быть положительным числом")
+var ErrRateBurstNotValid = errors.New("burst должен быть натуральным числом (больше нуля)")
+
+var ErrCartPartial = errors.New("информация о части товаров временно недоступна")
+var ErrCartItemUnavailable = errors.New("товары больше не продаются, удалите их из корзины")
+var ErrPriceChanged = errors.New("цены товаров изменились, подтвердите новую сумму заказа")
+
+var ErrPromoCodeNotValid = errors.New("промокод не должен быть пустым")
+var ErrPromoNotFound = errors.New("промокод не существует")
+var ErrPromoNotApplicable = errors.New("промокод не применим к корзине")
+
+var ErrSessionTokenNotValid = errors.New("токен сессии не должен быть пустым")
+var ErrSessionNotFound = errors.New("сессия не существует")
+var ErrMergePolicyNotValid = errors.New("правило объединения должно быть одним из: sum, max, keep_user")
+
+var ErrMoneyOverflow = errors.New("переполнение денежной суммы")
+var ErrCurrencyMismatch = errors.New("валюты сумм не совпадают")
+var ErrCurrencyRateNotFound = errors.New("нет курса для пересчета валюты")
+
+// UnavailableItemsError ошибка оформления корзины с товарами, которых больше нет в сервисе product.
+type UnavailableItemsError struct {
+	Skus []int64
+}
+
+// Error возвращает сообщение со списком SKU недоступных товаров.
+func (e *UnavailableItemsError) Error() string {
+	skus := make([]string, 0, len(e.Skus))
+	for _, sku := range e.Skus {
+		skus = append(skus, strconv.FormatInt(sku, 10))
+	}
+
+	return fmt.Sprintf("%s: sku %s", ErrCartItemUnavailable, strings.Join(skus, ", "))
+}
+
+// Unwrap возвращает ErrCartItemUnavailable.
+func (e *UnavailableItemsError) Unwrap() error {
+	return ErrCartItemUnavailable
+}
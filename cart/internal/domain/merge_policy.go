@@ -0,0 +1,37 @@
+package domain
+
+import "math"
+
+// MergePolicy правило объединения количества товара, который есть и в гостевой корзине, и в корзине пользователя.
+type MergePolicy string
+
+const (
+	// MergeSum количества складываются.
+	MergeSum MergePolicy = "sum"
+	// MergeMax берется большее из количеств.
+	MergeMax MergePolicy = "max"
+	// MergeKeepUser остается количество из корзины пользователя.
+	MergeKeepUser MergePolicy = "keep_user"
+)
+
+// IsValid возвращает true для известных правил объединения.
+func (p MergePolicy) IsValid() bool {
+	switch p {
+	case MergeSum, MergeMax, MergeKeepUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Merge возвращает количество товара после объединения корзин.
+func (p MergePolicy) Merge(userCount, guestCount uint32) uint32 {
+	switch p {
+	case MergeMax:
+		return max(userCount, guestCount)
+	case MergeKeepUser:
+		return userCount
+	default:
+		return uint32(min(uint64(userCount)+uint64(guestCount), math.MaxUint32))
+	}
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// DefaultCurrency валюта, в которой сервис product возвращает цены.
+const DefaultCurrency = "RUB"
+
+// defaultMinorUnitExponent количество знаков после запятой в суммах большинства валют.
+const defaultMinorUnitExponent = 2
+
+// minorUnitExponents количество знаков после запятой в суммах валют, у которых оно отличается
+// от defaultMinorUnitExponent (по ISO 4217).
+var minorUnitExponents = map[string]int{
+	"CLP": 0,
+	"ISK": 0,
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+	"BHD": 3,
+	"IQD": 3,
+	"JOD": 3,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"TND": 3,
+}
+
+// MinorUnitExponent возвращает количество знаков после запятой в суммах валюты currency:
+// в основной единице валюты 10^exponent минимальных единиц.
+func MinorUnitExponent(currency string) int {
+	if exponent, ok := minorUnitExponents[currency]; ok {
+		return exponent
+	}
+
+	return defaultMinorUnitExponent
+}
+
+// MoneyOverflowError ошибка переполнения при операции с денежными суммами.
+type MoneyOverflowError struct {
+	Op    string
+	Left  Money
+	Right string
+}
+
+func (e *MoneyOverflowError) Error() string {
+	return fmt.Sprintf("%s: %s %s %s", ErrMoneyOverflow, e.Left, e.Op, e.Right)
+}
+
+// Unwrap позволяет проверять ошибку через errors.Is(err, ErrMoneyOverflow).
+func (e *MoneyOverflowError) Unwrap() error {
+	return ErrMoneyOverflow
+}
+
+// Money денежная сумма в минимальных единицах валюты (например, копейках).
+// Арифметические операции проверяют переполнение и совпадение валют.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// NewMoney создает сумму amount в минимальных единицах валюты currency.
+func NewMoney(amount int64, currency string) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// ZeroMoney возвращает нулевую сумму в валюте currency.
+func ZeroMoney(currency string) Money {
+	return Money{Currency: currency}
+}
+
+// IsZero возвращает true, если сумма равна нулю.
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String возвращает сумму в виде "<amount> <currency>".
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+// Add возвращает сумму m и other.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, &MoneyOverflowError{Op: "+", Left: m, Right: other.String()}
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+// Sub возвращает разность m и other.
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, &MoneyOverflowError{Op: "-", Left: m, Right: other.String()}
+	}
+
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+// Mul возвращает сумму m, умноженную на n.
+func (m Money) Mul(n uint32) (Money, error) {
+	if n != 0 && (m.Amount > math.MaxInt64/int64(n) || m.Amount < math.MinInt64/int64(n)) {
+		return Money{}, &MoneyOverflowError{Op: "*", Left: m, Right: strconv.FormatUint(uint64(n), 10)}
+	}
+
+	return Money{Amount: m.Amount * int64(n), Currency: m.Currency}, nil
+}
+
+// Percent возвращает percent процентов от суммы m с округлением к нулю.
+// Значения percent больше 100 считаются равными 100.
+func (m Money) Percent(percent uint32) Money {
+	p := int64(min(percent, 100))
+
+	// Деление до умножения исключает переполнение на больших суммах.
+	return Money{Amount: m.Amount/100*p + m.Amount%100*p/100, Currency: m.Currency}
+}
+
+// Min возвращает меньшую из сумм m и other. Валюты должны совпадать.
+func (m Money) Min(other Money) (Money, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return Money{}, err
+	}
+
+	if other.Amount < m.Amount {
+		return other, nil
+	}
+
+	return m, nil
+}
+
+// Less возвращает true, если сумма m меньше other. Валюты должны совпадать.
+func (m Money) Less(other Money) (bool, error) {
+	if err := m.checkCurrency(other); err != nil {
+		return false, err
+	}
+
+	return m.Amount < other.Amount, nil
+}
+
+// Convert переводит сумму m в валюту currency по курсу rate (количество основных единиц currency
+// за основную единицу валюты m). Разное количество знаков после запятой у валют учитывается при пересчете
+// минимальных единиц. Результат округляется до минимальной единицы, половины округляются от нуля.
+func (m Money) Convert(rate *big.Rat, currency string) (Money, error) {
+	if m.Currency == currency {
+		return m, nil
+	}
+
+	converted := new(big.Rat).Mul(new(big.Rat).SetInt64(m.Amount), rate)
+	converted.Mul(converted, minorUnitScale(m.Currency, currency))
+
+	// Округление половины от нуля: |x| + 1/2 с отбрасыванием дробной части и возвратом знака.
+	num := new(big.Int).Abs(converted.Num())
+	den := new(big.Int).Mul(converted.Denom(), big.NewInt(2))
+	num.Mul(num, big.NewInt(2)).Add(num, converted.Denom())
+	amount := num.Quo(num, den)
+	if converted.Sign() < 0 {
+		amount.Neg(amount)
+	}
+
+	if !amount.IsInt64() {
+		return Money{}, &MoneyOverflowError{Op: "*", Left: m, Right: rate.RatString()}
+	}
+
+	return Money{Amount: amount.Int64(), Currency: currency}, nil
+}
+
+// minorUnitScale возвращает количество минимальных единиц валюты to в минимальной единице валюты from
+// при равенстве основных единиц.
+func minorUnitScale(from, to string) *big.Rat {
+	diff := MinorUnitExponent(to) - MinorUnitExponent(from)
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(max(diff, -diff))), nil)
+	if diff < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), pow)
+	}
+
+	return new(big.Rat).SetInt(pow)
+}
+
+func (m Money) checkCurrency(other Money) error {
+	if m.Currency != other.Currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	return nil
+}
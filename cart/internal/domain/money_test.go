@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney(t *testing.T) {
+	t.Parallel()
+
+	t.Run("add and sub", func(t *testing.T) {
+		t.Parallel()
+
+		sum, err := NewMoney(150, "RUB").Add(NewMoney(50, "RUB"))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(200, "RUB"), sum)
+
+		diff, err := sum.Sub(NewMoney(250, "RUB"))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(-50, "RUB"), diff)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewMoney(math.MaxInt64, "RUB").Add(NewMoney(1, "RUB"))
+		require.ErrorIs(t, err, ErrMoneyOverflow)
+
+		var overflowErr *MoneyOverflowError
+		require.ErrorAs(t, err, &overflowErr)
+		assert.Equal(t, "+", overflowErr.Op)
+
+		_, err = NewMoney(math.MinInt64, "RUB").Sub(NewMoney(1, "RUB"))
+		require.ErrorIs(t, err, ErrMoneyOverflow)
+
+		_, err = NewMoney(math.MaxInt64/2+1, "RUB").Mul(2)
+		require.ErrorIs(t, err, ErrMoneyOverflow)
+	})
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewMoney(1, "RUB").Add(NewMoney(1, "USD"))
+		require.ErrorIs(t, err, ErrCurrencyMismatch)
+	})
+
+	t.Run("percent", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, NewMoney(12, "RUB"), NewMoney(125, "RUB").Percent(10))
+		assert.Equal(t, NewMoney(125, "RUB"), NewMoney(125, "RUB").Percent(150))
+		assert.Equal(t, NewMoney(math.MaxInt64/2, "RUB"), NewMoney(math.MaxInt64, "RUB").Percent(50))
+	})
+
+	t.Run("convert", func(t *testing.T) {
+		t.Parallel()
+
+		converted, err := NewMoney(1000, "USD").Convert(big.NewRat(185, 2), "RUB")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(92500, "RUB"), converted)
+
+		converted, err = NewMoney(5, "RUB").Convert(big.NewRat(1, 10), "USD")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1, "USD"), converted)
+
+		converted, err = NewMoney(-5, "RUB").Convert(big.NewRat(1, 10), "USD")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(-1, "USD"), converted)
+
+		_, err = NewMoney(math.MaxInt64, "USD").Convert(big.NewRat(2, 1), "RUB")
+		require.ErrorIs(t, err, ErrMoneyOverflow)
+	})
+
+	t.Run("convert between currencies with different minor units", func(t *testing.T) {
+		t.Parallel()
+
+		converted, err := NewMoney(1000, "USD").Convert(big.NewRat(150, 1), "JPY")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1500, "JPY"), converted)
+
+		converted, err = NewMoney(1500, "JPY").Convert(big.NewRat(1, 150), "USD")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(1000, "USD"), converted)
+
+		converted, err = NewMoney(1000, "USD").Convert(big.NewRat(3, 10), "KWD")
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(3000, "KWD"), converted)
+	})
+}
@@ -3,6 +3,6 @@ package domain
 // Product хранит данные о товаре.
 type Product struct {
 	Name  string
-	Price uint32
+	Price Money
 	Sku   int64
 }
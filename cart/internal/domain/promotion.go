@@ -0,0 +1,45 @@
+package domain
+
+// PromoRuleKind тип правила промокода.
+type PromoRuleKind string
+
+const (
+	// PromoPercentage скидка в процентах от суммы корзины.
+	PromoPercentage PromoRuleKind = "percentage"
+	// PromoFixedAmount скидка фиксированной суммой.
+	PromoFixedAmount PromoRuleKind = "fixed_amount"
+	// PromoBuyNGetM при покупке Buy единиц товара Sku еще Free единиц бесплатно.
+	PromoBuyNGetM PromoRuleKind = "buy_n_get_m"
+	// PromoMinTotal условие: промокод действует, только если сумма корзины не меньше MinTotal.
+	PromoMinTotal PromoRuleKind = "min_total"
+)
+
+// PromoRule правило промокода. Используются только поля, относящиеся к Kind.
+type PromoRule struct {
+	Kind PromoRuleKind
+	// Percent размер скидки в процентах для PromoPercentage.
+	Percent uint32
+	// Amount размер скидки для PromoFixedAmount в минимальных единицах валюты корзины.
+	Amount int64
+	// Sku, Buy и Free параметры для PromoBuyNGetM.
+	Sku  int64
+	Buy  uint32
+	Free uint32
+	// MinTotal минимальная сумма корзины для PromoMinTotal в минимальных единицах валюты корзины.
+	MinTotal int64
+}
+
+// Promotion хранит данные о промокоде.
+type Promotion struct {
+	Code        string
+	Description string
+	Rules       []PromoRule
+}
+
+// Discount строка скидки в корзине, полученная по одному правилу промокода.
+type Discount struct {
+	PromoCode   string
+	Description string
+	Kind        PromoRuleKind
+	Amount      Money
+}
@@ -2,7 +2,6 @@ package handler
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
 	"route256/cart/internal/domain"
 )
@@ -14,7 +13,7 @@ type AddCartItemRequest struct {
 type AddCartItemResponse struct {
 	Sku   int64  `json:"sku"`
 	Name  string `json:"name"`
-	Price uint32 `json:"price"`
+	Price int64  `json:"price"`
 	Count uint32 `json:"count"`
 }
 
@@ -33,7 +32,7 @@ func (s *Server) AddCartItemHandler(w http.ResponseWriter, r *http.Request) {
 		ParseStruct(&request, fieldErrors).
 		Errors()
 	if errs != nil {
-		MakeErrorResponseByErrs(w, errs)
+		MakeErrorResponseByErrs(r.Context(), w, errs)
 		return
 	}
 
@@ -44,31 +43,21 @@ func (s *Server) AddCartItemHandler(w http.ResponseWriter, r *http.Request) {
 
 	addedCartItem, err := s.cartService.AddCartItem(r.Context(), userID, cartItem)
 	if err != nil {
-		if errors.Is(err, domain.ErrProductNotFound) {
-			MakeErrorResponse(w, domain.ErrProductNotFound, http.StatusPreconditionFailed)
-			return
-		}
-
-		if errors.Is(err, domain.ErrOutOfStock) {
-			MakeErrorResponse(w, domain.ErrOutOfStock, http.StatusPreconditionFailed)
-			return
-		}
-
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
 	response := &AddCartItemResponse{
 		Sku:   addedCartItem.Sku,
 		Name:  addedCartItem.Name,
-		Price: addedCartItem.Price,
+		Price: addedCartItem.Price.Amount,
 		Count: addedCartItem.Count,
 	}
 
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 }
@@ -9,6 +9,12 @@ import (
 	"route256/cart/pkg/logger"
 )
 
+type CheckoutCartRequest struct {
+	// ExpectedTotal сумма заказа, которую подтверждает пользователь.
+	// Обязательна, если цены товаров изменились с момента добавления в корзину.
+	ExpectedTotal *int64 `json:"expected_total"`
+}
+
 type CheckoutCartResponse struct {
 	OrderID int64 `json:"order_id"`
 }
@@ -18,31 +24,54 @@ type OrderCheckouter interface {
 }
 
 // CheckoutCartHandler оформляет заказ по товарам из корзины.
+// Если цены изменились с момента добавления товаров, заказ оформляется только после подтверждения новой суммы.
 func (s *Server) CheckoutCartHandler(w http.ResponseWriter, r *http.Request) {
 	var userID int64
+	var request CheckoutCartRequest
 	errs := NewRequestValidator(r).
 		ParseUserID(&userID).
+		ParseOptionalStruct(&request, nil).
 		Errors()
 	if errs != nil {
-		MakeErrorResponseByErrs(w, errs)
+		MakeErrorResponseByErrs(r.Context(), w, errs)
 		return
 	}
 
 	ctx := r.Context()
 	cart, err := s.cartService.GetCart(ctx, userID)
 	if err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
 	if len(cart.Items) == 0 {
-		MakeErrorResponse(w, domain.ErrCartNotFound, http.StatusNotFound)
+		MakeErrorResponse(r.Context(), w, domain.ErrCartNotFound)
+		return
+	}
+
+	if cart.Partial {
+		MakeErrorResponse(r.Context(), w, domain.ErrCartPartial)
+		return
+	}
+
+	if skus := cart.UnavailableSkus(); len(skus) > 0 {
+		MakeErrorResponse(r.Context(), w, &domain.UnavailableItemsError{Skus: skus})
+		return
+	}
+
+	if cart.MixedCurrencies {
+		MakeErrorResponse(r.Context(), w, domain.ErrCurrencyMismatch)
+		return
+	}
+
+	if !isTotalConfirmed(cart, request.ExpectedTotal) {
+		MakeErrorResponse(r.Context(), w, domain.ErrPriceChanged)
 		return
 	}
 
 	orderID, err := s.orderCheckouter.OrderCreate(ctx, userID, cart)
 	if err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
@@ -58,7 +87,17 @@ func (s *Server) CheckoutCartHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 }
+
+// isTotalConfirmed проверяет, что пользователь согласен с текущей суммой корзины.
+// Если сумма передана, она должна совпадать с текущей; если нет - цены не должны были измениться.
+func isTotalConfirmed(cart *domain.Cart, expectedTotal *int64) bool {
+	if expectedTotal != nil {
+		return *expectedTotal == cart.TotalPrice.Amount
+	}
+
+	return !cart.PriceChanged
+}
@@ -11,13 +11,13 @@ func (s *Server) ClearCartHandler(w http.ResponseWriter, r *http.Request) {
 		ParseUserID(&userID).
 		Errors()
 	if errs != nil {
-		MakeErrorResponseByErrs(w, errs)
+		MakeErrorResponseByErrs(r.Context(), w, errs)
 		return
 	}
 
 	err := s.cartService.ClearCart(r.Context(), userID)
 	if err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
@@ -13,13 +13,13 @@ func (s *Server) DeleteCartItemHandler(w http.ResponseWriter, r *http.Request) {
 		ParseSkuID(&skuID).
 		Errors()
 	if errs != nil {
-		MakeErrorResponseByErrs(w, errs)
+		MakeErrorResponseByErrs(r.Context(), w, errs)
 		return
 	}
 
 	err := s.cartService.DeleteCartItem(r.Context(), userID, skuID)
 	if err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
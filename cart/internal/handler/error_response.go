@@ -1,27 +1,190 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"route256/cart/internal/domain"
+	"route256/cart/internal/handler/validate"
+	"route256/cart/pkg/logger"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
+// ErrorCode стабильный машиночитаемый код ошибки в ответе.
+type ErrorCode string
+
+const (
+	CodeValidationFailed     ErrorCode = "VALIDATION_FAILED"
+	CodeUserIDNotValid       ErrorCode = "USER_ID_NOT_VALID"
+	CodeSKUNotValid          ErrorCode = "SKU_NOT_VALID"
+	CodeCountNotValid        ErrorCode = "COUNT_NOT_VALID"
+	CodeRequestBodyInvalid   ErrorCode = "REQUEST_BODY_NOT_VALID"
+	CodeFieldNotValid        ErrorCode = "FIELD_NOT_VALID"
+	CodeRateLimitNotValid    ErrorCode = "RATE_LIMIT_NOT_VALID"
+	CodeRateBurstNotValid    ErrorCode = "RATE_BURST_NOT_VALID"
+	CodePromoCodeNotValid    ErrorCode = "PROMO_CODE_NOT_VALID"
+	CodeSessionTokenNotValid ErrorCode = "SESSION_TOKEN_NOT_VALID"
+	CodeMergePolicyNotValid  ErrorCode = "MERGE_POLICY_NOT_VALID"
+	CodeCartNotFound         ErrorCode = "CART_NOT_FOUND"
+	CodeCartItemNotFound     ErrorCode = "CART_ITEM_NOT_FOUND"
+	CodeSavedItemNotFound    ErrorCode = "SAVED_ITEM_NOT_FOUND"
+	CodeSessionNotFound      ErrorCode = "SESSION_NOT_FOUND"
+	CodeProductNotFound      ErrorCode = "PRODUCT_NOT_FOUND"
+	CodeOutOfStock           ErrorCode = "OUT_OF_STOCK"
+	CodeStockNotFound        ErrorCode = "STOCK_NOT_FOUND"
+	CodeNotEnoughStock       ErrorCode = "NOT_ENOUGH_STOCK"
+	CodeServiceUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+	CodeCartPartial          ErrorCode = "CART_PARTIAL"
+	CodeCartItemUnavailable  ErrorCode = "CART_ITEM_UNAVAILABLE"
+	CodePriceChanged         ErrorCode = "PRICE_CHANGED"
+	CodePromoNotFound        ErrorCode = "PROMO_NOT_FOUND"
+	CodePromoNotApplicable   ErrorCode = "PROMO_NOT_APPLICABLE"
+	CodeMoneyOverflow        ErrorCode = "MONEY_OVERFLOW"
+	CodeCurrencyMismatch     ErrorCode = "CURRENCY_MISMATCH"
+	CodeCurrencyRateNotFound ErrorCode = "CURRENCY_RATE_NOT_FOUND"
+	CodeInternal             ErrorCode = "INTERNAL"
+)
+
+const (
+	validationFailedMessage = "запрос не прошел валидацию"
+	internalErrorMessage    = "внутренняя ошибка сервиса"
+)
+
+// ErrorResponse тело ответа с ошибкой.
+type ErrorResponse struct {
+	Code    ErrorCode    `json:"code"`
+	Message string       `json:"message"`
+	Details []FieldError `json:"details,omitempty"`
+	TraceID string       `json:"trace_id,omitempty"`
+}
+
+// FieldError описывает ошибку валидации отдельного поля запроса.
+type FieldError struct {
+	Field   string    `json:"field"`
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// errorMapping описывает, как доменная ошибка отображается в ответ.
+// Ошибки с непустым field считаются ошибками валидации поля.
+type errorMapping struct {
+	err    error
+	code   ErrorCode
+	status int
+	field  string
+}
+
+var errorMappings = []errorMapping{
+	{err: domain.ErrUserIDNotValid, code: CodeUserIDNotValid, status: http.StatusBadRequest, field: "user_id"},
+	{err: domain.ErrSKUNotValid, code: CodeSKUNotValid, status: http.StatusBadRequest, field: "sku_id"},
+	{err: domain.ErrCountNotValid, code: CodeCountNotValid, status: http.StatusBadRequest, field: "count"},
+	{err: domain.ErrRequestBodyNotValid, code: CodeRequestBodyInvalid, status: http.StatusBadRequest, field: "body"},
+	{err: domain.ErrRateLimitNotValid, code: CodeRateLimitNotValid, status: http.StatusBadRequest, field: "limit"},
+	{err: domain.ErrRateBurstNotValid, code: CodeRateBurstNotValid, status: http.StatusBadRequest, field: "burst"},
+	{err: domain.ErrPromoCodeNotValid, code: CodePromoCodeNotValid, status: http.StatusBadRequest, field: "code"},
+	{err: domain.ErrSessionTokenNotValid, code: CodeSessionTokenNotValid, status: http.StatusBadRequest, field: "session_token"},
+	{err: domain.ErrMergePolicyNotValid, code: CodeMergePolicyNotValid, status: http.StatusBadRequest, field: "policy"},
+	{err: domain.ErrCartNotFound, code: CodeCartNotFound, status: http.StatusNotFound},
+	{err: domain.ErrCartItemNotFound, code: CodeCartItemNotFound, status: http.StatusNotFound},
+	{err: domain.ErrSavedItemNotFound, code: CodeSavedItemNotFound, status: http.StatusNotFound},
+	{err: domain.ErrSessionNotFound, code: CodeSessionNotFound, status: http.StatusNotFound},
+	{err: domain.ErrProductNotFound, code: CodeProductNotFound, status: http.StatusPreconditionFailed},
+	{err: domain.ErrOutOfStock, code: CodeOutOfStock, status: http.StatusPreconditionFailed},
+	{err: domain.ErrStockNotFound, code: CodeStockNotFound, status: http.StatusNotFound},
+	{err: domain.ErrNotEnoughStock, code: CodeNotEnoughStock, status: http.StatusConflict},
+	{err: domain.ErrLomsUnavailable, code: CodeServiceUnavailable, status: http.StatusServiceUnavailable},
+	{err: domain.ErrCartPartial, code: CodeCartPartial, status: http.StatusServiceUnavailable},
+	{err: domain.ErrCartItemUnavailable, code: CodeCartItemUnavailable, status: http.StatusConflict},
+	{err: domain.ErrPriceChanged, code: CodePriceChanged, status: http.StatusConflict},
+	{err: domain.ErrPromoNotFound, code: CodePromoNotFound, status: http.StatusNotFound},
+	{err: domain.ErrPromoNotApplicable, code: CodePromoNotApplicable, status: http.StatusConflict},
+	{err: domain.ErrMoneyOverflow, code: CodeMoneyOverflow, status: http.StatusUnprocessableEntity},
+	{err: domain.ErrCurrencyMismatch, code: CodeCurrencyMismatch, status: http.StatusUnprocessableEntity},
+	{err: domain.ErrCurrencyRateNotFound, code: CodeCurrencyRateNotFound, status: http.StatusUnprocessableEntity},
+}
+
+func findErrorMapping(err error) (errorMapping, bool) {
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			return m, true
+		}
+	}
+
+	return errorMapping{}, false
+}
+
 // MakeErrorResponse формирует и отправляет ответ с ошибкой в формате JSON.
-func MakeErrorResponse(w http.ResponseWriter, err error, statusCode int) {
-	type ErrorMessage struct {
-		Message string
+// Код ответа и код ошибки определяются по доменной ошибке, неизвестные ошибки считаются внутренними.
+func MakeErrorResponse(ctx context.Context, w http.ResponseWriter, err error) {
+	m, ok := findErrorMapping(err)
+	if !ok {
+		logger.ErrorwCtx(ctx, fmt.Sprintf("internal error: %s", err))
+		writeErrorResponse(ctx, w, http.StatusInternalServerError, &ErrorResponse{
+			Code:    CodeInternal,
+			Message: internalErrorMessage,
+		})
+		return
+	}
+
+	if m.field != "" {
+		MakeErrorResponseByErrs(ctx, w, []error{err})
+		return
+	}
+
+	message := m.err.Error()
+	var itemsErr *domain.UnavailableItemsError
+	if errors.As(err, &itemsErr) {
+		message = itemsErr.Error()
+	}
+
+	writeErrorResponse(ctx, w, m.status, &ErrorResponse{
+		Code:    m.code,
+		Message: message,
+	})
+}
+
+// MakeErrorResponseByErrs отправляет ответ со всеми ошибками валидации запроса.
+// Ошибки проверки полей без своей доменной ошибки попадают в ответ с именем поля и кодом FIELD_NOT_VALID.
+func MakeErrorResponseByErrs(ctx context.Context, w http.ResponseWriter, errs []error) {
+	details := make([]FieldError, 0, len(errs))
+	for _, err := range errs {
+		m, ok := findErrorMapping(err)
+		if !ok || m.field == "" {
+			m = errorMapping{code: CodeRequestBodyInvalid, field: "body"}
+
+			var fieldErr *validate.FieldError
+			if errors.As(err, &fieldErr) {
+				m = errorMapping{code: CodeFieldNotValid, field: fieldErr.Field}
+			}
+		}
+
+		details = append(details, FieldError{
+			Field:   m.field,
+			Code:    m.code,
+			Message: err.Error(),
+		})
+	}
+
+	writeErrorResponse(ctx, w, http.StatusBadRequest, &ErrorResponse{
+		Code:    CodeValidationFailed,
+		Message: validationFailedMessage,
+		Details: details,
+	})
+}
+
+func writeErrorResponse(ctx context.Context, w http.ResponseWriter, statusCode int, errResponse *ErrorResponse) {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		errResponse.TraceID = sc.TraceID().String()
 	}
 
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
-	errResponse := &ErrorMessage{Message: err.Error()}
-	if errE := json.NewEncoder(w).Encode(errResponse); errE != nil {
-		fmt.Println(errE)
+	if err := json.NewEncoder(w).Encode(errResponse); err != nil {
+		logger.ErrorwCtx(ctx, fmt.Sprintf("json.Encode: %s", err))
 		return
 	}
 }
-
-func MakeErrorResponseByErrs(w http.ResponseWriter, errs []error) {
-	MakeErrorResponse(w, errs[0], http.StatusBadRequest)
-}
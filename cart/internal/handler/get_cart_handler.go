@@ -6,16 +6,43 @@ import (
 	"route256/cart/internal/domain"
 )
 
+// Денежные суммы в ответах передаются в минимальных единицах валюты Currency.
 type CartResponse struct {
-	Items      []CartItemResponse `json:"items"`
-	TotalPrice uint32             `json:"total_price"`
+	Items        []CartItemResponse `json:"items"`
+	Currency     string             `json:"currency"`
+	Subtotal     int64              `json:"subtotal"`
+	PromoCode    string             `json:"promo_code,omitempty"`
+	Discounts    []DiscountResponse `json:"discounts"`
+	TotalPrice   int64              `json:"total_price"`
+	Partial      bool               `json:"partial"`
+	PriceChanged bool               `json:"price_changed"`
+	// Totals суммы товаров без учета скидок отдельно по каждой валюте.
+	Totals []MoneyResponse `json:"totals"`
+	// MixedCurrencies признак того, что в корзине товары в разных валютах и общая сумма не считается.
+	MixedCurrencies bool `json:"mixed_currencies"`
+}
+
+type MoneyResponse struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type DiscountResponse struct {
+	PromoCode   string `json:"promo_code"`
+	Description string `json:"description"`
+	Kind        string `json:"kind"`
+	Amount      int64  `json:"amount"`
 }
 
 type CartItemResponse struct {
-	Sku   int64  `json:"sku"`
-	Name  string `json:"name"`
-	Price uint32 `json:"price"`
-	Count uint32 `json:"count"`
+	Sku          int64  `json:"sku"`
+	Name         string `json:"name"`
+	Price        int64  `json:"price"`
+	Currency     string `json:"currency"`
+	Count        uint32 `json:"count"`
+	Availability string `json:"availability"`
+	AddedPrice   int64  `json:"added_price"`
+	PriceChanged bool   `json:"price_changed"`
 }
 
 // GetCartHandler обрабатывает HTTP-запрос на получение содержимого корзины пользователя.
@@ -25,39 +52,76 @@ func (s *Server) GetCartHandler(w http.ResponseWriter, r *http.Request) {
 		ParseUserID(&userID).
 		Errors()
 	if errs != nil {
-		MakeErrorResponseByErrs(w, errs)
+		MakeErrorResponseByErrs(r.Context(), w, errs)
 		return
 	}
 
 	cart, err := s.cartService.GetCart(r.Context(), userID)
 	if err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		MakeErrorResponse(r.Context(), w, err)
 		return
 	}
 
 	if len(cart.Items) == 0 {
-		MakeErrorResponse(w, domain.ErrCartNotFound, http.StatusNotFound)
+		MakeErrorResponse(r.Context(), w, domain.ErrCartNotFound)
 		return
 	}
 
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartResponse(cart)); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+func newCartResponse(cart *domain.Cart) *CartResponse {
 	response := &CartResponse{
-		Items:      make([]CartItemResponse, 0, len(cart.Items)),
-		TotalPrice: cart.TotalPrice,
+		Items:        make([]CartItemResponse, 0, len(cart.Items)),
+		Currency:     cart.TotalPrice.Currency,
+		Subtotal:     cart.Subtotal.Amount,
+		PromoCode:    cart.PromoCode,
+		Discounts:    make([]DiscountResponse, 0, len(cart.Discounts)),
+		TotalPrice:   cart.TotalPrice.Amount,
+		Partial:      cart.Partial,
+		PriceChanged: cart.PriceChanged,
+		Totals:       make([]MoneyResponse, 0, len(cart.Totals)),
+
+		MixedCurrencies: cart.MixedCurrencies,
 	}
 
 	for _, item := range cart.Items {
-		response.Items = append(response.Items, CartItemResponse{
-			Sku:   item.Sku,
-			Name:  item.Name,
-			Count: item.Count,
-			Price: item.Price,
+		response.Items = append(response.Items, newCartItemResponse(item))
+	}
+
+	for _, total := range cart.Totals {
+		response.Totals = append(response.Totals, MoneyResponse{
+			Amount:   total.Amount,
+			Currency: total.Currency,
 		})
 	}
 
-	w.Header().Add("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(response); err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
-		return
+	for _, discount := range cart.Discounts {
+		response.Discounts = append(response.Discounts, DiscountResponse{
+			PromoCode:   discount.PromoCode,
+			Description: discount.Description,
+			Kind:        string(discount.Kind),
+			Amount:      discount.Amount.Amount,
+		})
+	}
+
+	return response
+}
+
+func newCartItemResponse(item *domain.CartItem) CartItemResponse {
+	return CartItemResponse{
+		Sku:          item.Sku,
+		Name:         item.Name,
+		Count:        item.Count,
+		Price:        item.Price.Amount,
+		Currency:     item.Price.Currency,
+		Availability: item.Availability.String(),
+		AddedPrice:   item.AddedPrice.Amount,
+		PriceChanged: item.PriceChanged,
 	}
 }
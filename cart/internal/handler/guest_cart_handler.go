@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"route256/cart/internal/domain"
+)
+
+type CreateGuestSessionResponse struct {
+	SessionToken string `json:"session_token"`
+}
+
+// CreateGuestSessionHandler обрабатывает HTTP-запрос на создание сессии анонимного пользователя.
+// Полученный токен передается в заголовке X-Session-Token в запросах к гостевой корзине.
+func (s *Server) CreateGuestSessionHandler(w http.ResponseWriter, r *http.Request) {
+	token, err := s.guestSessions.CreateSession(r.Context())
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	response := &CreateGuestSessionResponse{
+		SessionToken: token,
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// AddGuestCartItemHandler обрабатывает HTTP-запрос на добавление товара в гостевую корзину.
+func (s *Server) AddGuestCartItemHandler(w http.ResponseWriter, r *http.Request) {
+	fieldErrors := map[string]error{
+		"Count": domain.ErrCountNotValid,
+	}
+
+	var token string
+	var skuID int64
+	var request AddCartItemRequest
+	errs := NewRequestValidator(r).
+		ParseSessionToken(&token).
+		ParseSkuID(&skuID).
+		ParseStruct(&request, fieldErrors).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	cartID, err := s.guestSessions.GetCartIDByToken(r.Context(), token)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	cartItem := &domain.CartItem{
+		Sku:   skuID,
+		Count: request.Count,
+	}
+
+	addedCartItem, err := s.cartService.AddCartItem(r.Context(), cartID, cartItem)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	response := &AddCartItemResponse{
+		Sku:   addedCartItem.Sku,
+		Name:  addedCartItem.Name,
+		Price: addedCartItem.Price.Amount,
+		Count: addedCartItem.Count,
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// DeleteGuestCartItemHandler обрабатывает HTTP-запрос на удаление товара из гостевой корзины.
+func (s *Server) DeleteGuestCartItemHandler(w http.ResponseWriter, r *http.Request) {
+	var token string
+	var skuID int64
+	errs := NewRequestValidator(r).
+		ParseSessionToken(&token).
+		ParseSkuID(&skuID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	cartID, err := s.guestSessions.GetCartIDByToken(r.Context(), token)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	err = s.cartService.DeleteCartItem(r.Context(), cartID, skuID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetGuestCartHandler обрабатывает HTTP-запрос на получение содержимого гостевой корзины.
+func (s *Server) GetGuestCartHandler(w http.ResponseWriter, r *http.Request) {
+	var token string
+	errs := NewRequestValidator(r).
+		ParseSessionToken(&token).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	cartID, err := s.guestSessions.GetCartIDByToken(r.Context(), token)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	cart, err := s.cartService.GetCart(r.Context(), cartID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	if len(cart.Items) == 0 {
+		MakeErrorResponse(r.Context(), w, domain.ErrCartNotFound)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartResponse(cart)); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// ClearGuestCartHandler обрабатывает HTTP-запрос на очистку гостевой корзины.
+func (s *Server) ClearGuestCartHandler(w http.ResponseWriter, r *http.Request) {
+	var token string
+	errs := NewRequestValidator(r).
+		ParseSessionToken(&token).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	cartID, err := s.guestSessions.GetCartIDByToken(r.Context(), token)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	err = s.cartService.ClearCart(r.Context(), cartID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNoContent)
+}
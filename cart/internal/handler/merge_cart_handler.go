@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/logger"
+)
+
+type MergeCartRequest struct {
+	SessionToken string `json:"session_token" validate:"required"`
+	// Policy правило объединения: sum, max или keep_user. Если не указано, используется правило из конфига.
+	Policy string `json:"policy" validate:"omitempty,oneof=sum max keep_user"`
+}
+
+// MergeCartHandler обрабатывает HTTP-запрос на перенос гостевой корзины в корзину пользователя после входа.
+// После объединения сессия анонимного пользователя удаляется, в ответе возвращается корзина пользователя.
+func (s *Server) MergeCartHandler(w http.ResponseWriter, r *http.Request) {
+	fieldErrors := map[string]error{
+		"SessionToken": domain.ErrSessionTokenNotValid,
+		"Policy":       domain.ErrMergePolicyNotValid,
+	}
+
+	var userID int64
+	var request MergeCartRequest
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		ParseStruct(&request, fieldErrors).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	ctx := r.Context()
+	guestCartID, err := s.guestSessions.GetCartIDByToken(ctx, request.SessionToken)
+	if err != nil {
+		MakeErrorResponse(ctx, w, err)
+		return
+	}
+
+	cart, err := s.cartService.MergeCarts(ctx, userID, guestCartID, domain.MergePolicy(request.Policy))
+	if err != nil {
+		MakeErrorResponse(ctx, w, err)
+		return
+	}
+
+	err = s.guestSessions.DeleteSession(ctx, request.SessionToken)
+	if err != nil {
+		logger.ErrorwCtx(ctx, fmt.Sprintf("guestSessions.DeleteSession: %s", err))
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartResponse(cart)); err != nil {
+		MakeErrorResponse(ctx, w, err)
+		return
+	}
+}
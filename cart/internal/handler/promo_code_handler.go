@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"route256/cart/internal/domain"
+)
+
+type ApplyPromoCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// ApplyPromoCodeHandler обрабатывает HTTP-запрос на применение промокода к корзине пользователя.
+// В ответе возвращается корзина с рассчитанными скидками.
+func (s *Server) ApplyPromoCodeHandler(w http.ResponseWriter, r *http.Request) {
+	fieldErrors := map[string]error{
+		"Code": domain.ErrPromoCodeNotValid,
+	}
+
+	var userID int64
+	var request ApplyPromoCodeRequest
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		ParseStruct(&request, fieldErrors).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	cart, err := s.cartService.ApplyPromoCode(r.Context(), userID, request.Code)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartResponse(cart)); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// RemovePromoCodeHandler обрабатывает HTTP-запрос на удаление промокода из корзины пользователя.
+func (s *Server) RemovePromoCodeHandler(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	err := s.cartService.RemovePromoCode(r.Context(), userID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNoContent)
+}
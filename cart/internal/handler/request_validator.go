@@ -1,13 +1,20 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"route256/cart/internal/domain"
 	"route256/cart/internal/handler/validate"
 	"strconv"
+	"strings"
 )
 
+// SessionTokenHeader заголовок с токеном сессии анонимного пользователя.
+const SessionTokenHeader = "X-Session-Token"
+
 type RequestValidator struct {
 	errs      []error
 	validator *validate.ValidatorAdapter
@@ -43,20 +50,51 @@ func (iv *RequestValidator) ParseSkuID(skuID *int64) *RequestValidator {
 	return iv
 }
 
+// ParseSessionToken парсит из заголовка X-Session-Token и валидирует токен сессии анонимного пользователя.
+func (iv *RequestValidator) ParseSessionToken(token *string) *RequestValidator {
+	*token = strings.TrimSpace(iv.r.Header.Get(SessionTokenHeader))
+	if *token == "" {
+		iv.errs = append(iv.errs, domain.ErrSessionTokenNotValid)
+	}
+	return iv
+}
+
 // ParseStruct парсит из тела запроса (json) в структуру и валидирует её.
 // s - указатель на структуру.
 func (iv *RequestValidator) ParseStruct(s any, fieldErrors map[string]error) *RequestValidator {
 	if err := json.NewDecoder(iv.r.Body).Decode(s); err != nil {
-		iv.errs = append(iv.errs, err)
+		iv.errs = append(iv.errs, fmt.Errorf("%w: %s", domain.ErrRequestBodyNotValid, err))
+		return iv
 	}
 
 	err := iv.validator.Struct(s, fieldErrors)
-	if err != nil {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		iv.errs = append(iv.errs, joined.Unwrap()...)
+	} else if err != nil {
 		iv.errs = append(iv.errs, err)
 	}
 	return iv
 }
 
+// ParseOptionalStruct работает как ParseStruct, но допускает пустое тело запроса.
+func (iv *RequestValidator) ParseOptionalStruct(s any, fieldErrors map[string]error) *RequestValidator {
+	if iv.r.Body == nil || iv.r.Body == http.NoBody {
+		return iv
+	}
+
+	body, err := io.ReadAll(iv.r.Body)
+	if err != nil {
+		iv.errs = append(iv.errs, fmt.Errorf("%w: %s", domain.ErrRequestBodyNotValid, err))
+		return iv
+	}
+	if len(bytes.TrimSpace(body)) == 0 {
+		return iv
+	}
+
+	iv.r.Body = io.NopCloser(bytes.NewReader(body))
+	return iv.ParseStruct(s, fieldErrors)
+}
+
 // Errors возвращает слайс ошибок, если они были. Иначе nil.
 func (iv *RequestValidator) Errors() []error {
 	if len(iv.errs) == 0 {
@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type SavedItemsResponse struct {
+	Items []CartItemResponse `json:"items"`
+}
+
+// GetSavedItemsHandler обрабатывает HTTP-запрос на получение отложенных товаров пользователя.
+func (s *Server) GetSavedItemsHandler(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	items, err := s.cartService.GetSavedItems(r.Context(), userID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	response := &SavedItemsResponse{
+		Items: make([]CartItemResponse, 0, len(items)),
+	}
+	for _, item := range items {
+		response.Items = append(response.Items, newCartItemResponse(item))
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// SaveCartItemHandler обрабатывает HTTP-запрос на перенос товара из корзины в список отложенных.
+func (s *Server) SaveCartItemHandler(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	var skuID int64
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		ParseSkuID(&skuID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	item, err := s.cartService.SaveCartItemForLater(r.Context(), userID, skuID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartItemResponse(item)); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// MoveSavedItemToCartHandler обрабатывает HTTP-запрос на возврат отложенного товара в корзину.
+func (s *Server) MoveSavedItemToCartHandler(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	var skuID int64
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		ParseSkuID(&skuID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	item, err := s.cartService.MoveSavedItemToCart(r.Context(), userID, skuID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(newCartItemResponse(item)); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
+
+// DeleteSavedItemHandler обрабатывает HTTP-запрос на удаление товара из списка отложенных.
+func (s *Server) DeleteSavedItemHandler(w http.ResponseWriter, r *http.Request) {
+	var userID int64
+	var skuID int64
+	errs := NewRequestValidator(r).
+		ParseUserID(&userID).
+		ParseSkuID(&skuID).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	err := s.cartService.DeleteSavedItem(r.Context(), userID, skuID)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNoContent)
+}
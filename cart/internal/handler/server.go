@@ -15,18 +15,44 @@ type CartService interface {
 	ClearCart(ctx context.Context, userID int64) error
 	// Возвращает содержимое корзины пользователя
 	GetCart(ctx context.Context, userID int64) (*domain.Cart, error)
+	// Применяет промокод к корзине пользователя
+	ApplyPromoCode(ctx context.Context, userID int64, code string) (*domain.Cart, error)
+	// Удаляет промокод из корзины пользователя
+	RemovePromoCode(ctx context.Context, userID int64) error
+	// Возвращает отложенные товары пользователя
+	GetSavedItems(ctx context.Context, userID int64) ([]*domain.CartItem, error)
+	// Переносит товар из корзины в список отложенных
+	SaveCartItemForLater(ctx context.Context, userID, skuID int64) (*domain.CartItem, error)
+	// Возвращает отложенный товар в корзину
+	MoveSavedItemToCart(ctx context.Context, userID, skuID int64) (*domain.CartItem, error)
+	// Удаляет товар из списка отложенных
+	DeleteSavedItem(ctx context.Context, userID, skuID int64) error
+	// Переносит товары гостевой корзины в корзину пользователя
+	MergeCarts(ctx context.Context, userID, guestCartID int64, policy domain.MergePolicy) (*domain.Cart, error)
+}
+
+// GuestSessions для работы с сессиями анонимных пользователей.
+type GuestSessions interface {
+	// Создает сессию анонимного пользователя и возвращает ее токен
+	CreateSession(ctx context.Context) (string, error)
+	// Возвращает идентификатор гостевой корзины по токену сессии
+	GetCartIDByToken(ctx context.Context, token string) (int64, error)
+	// Удаляет сессию анонимного пользователя
+	DeleteSession(ctx context.Context, token string) error
 }
 
 // Server реализует HTTP-обработчики для работы с корзиной.
 type Server struct {
 	cartService     CartService
 	orderCheckouter OrderCheckouter
+	guestSessions   GuestSessions
 }
 
 // NewServer конструктор для Server.
-func NewServer(cartService CartService, orderCheckouter OrderCheckouter) *Server {
+func NewServer(cartService CartService, orderCheckouter OrderCheckouter, guestSessions GuestSessions) *Server {
 	return &Server{
 		cartService:     cartService,
 		orderCheckouter: orderCheckouter,
+		guestSessions:   guestSessions,
 	}
 }
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,21 +10,30 @@ import (
 	"net/http/httptest"
 	"route256/cart/internal/domain"
 	mock "route256/cart/mocks"
+	"route256/cart/pkg/logger"
+	"strings"
 	"testing"
 
 	"github.com/go-playground/assert/v2"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"go.uber.org/zap"
 )
 
 func TestMain(m *testing.M) {
+	logger.InitLogger(&logger.Config{Level: zap.FatalLevel})
 	goleak.VerifyTestMain(m)
 }
 
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
 type testComponentS struct {
 	cartServMock       *mock.CartServiceMock
 	orderCheckServMock *mock.OrderCheckouterMock
+	guestSessionsMock  *mock.GuestSessionsMock
 	server             *Server
 }
 
@@ -31,11 +41,13 @@ func newTestComponentS(t *testing.T) testComponentS {
 	mc := minimock.NewController(t)
 	cartServMock := mock.NewCartServiceMock(mc)
 	orderCheckServMock := mock.NewOrderCheckouterMock(mc)
-	server := NewServer(cartServMock, orderCheckServMock)
+	guestSessionsMock := mock.NewGuestSessionsMock(mc)
+	server := NewServer(cartServMock, orderCheckServMock, guestSessionsMock)
 
 	return testComponentS{
 		cartServMock:       cartServMock,
 		orderCheckServMock: orderCheckServMock,
+		guestSessionsMock:  guestSessionsMock,
 		server:             server,
 	}
 }
@@ -56,7 +68,7 @@ func TestService(t *testing.T) {
 		itemOut := &domain.CartItem{
 			Sku:   skuID,
 			Name:  "Name 1",
-			Price: 100,
+			Price: rub(100),
 			Count: itemReq.Count,
 		}
 
@@ -124,6 +136,64 @@ func TestService(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, res.StatusCode)
 	})
 
+	t.Run("add cart item returns all validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+		itemReq := AddCartItemRequest{
+			Count: 0,
+		}
+		userID := int64(-1)
+		skuID := int64(-1)
+
+		res := tc.addCartItem(t, userID, skuID, itemReq)
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		errRes := decodeErrorResponse(t, res)
+		assert.Equal(t, CodeValidationFailed, errRes.Code)
+		assert.Equal(t, []FieldError{
+			{Field: "user_id", Code: CodeUserIDNotValid, Message: domain.ErrUserIDNotValid.Error()},
+			{Field: "sku_id", Code: CodeSKUNotValid, Message: domain.ErrSKUNotValid.Error()},
+			{Field: "count", Code: CodeCountNotValid, Message: domain.ErrCountNotValid.Error()},
+		}, errRes.Details)
+	})
+
+	t.Run("add cart item with unexisiting product sku returns error code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+		itemReq := AddCartItemRequest{
+			Count: 1,
+		}
+
+		tc.cartServMock.AddCartItemMock.Return(nil, fmt.Errorf("productService.GetProductBySku: %w", domain.ErrProductNotFound))
+
+		res := tc.addCartItem(t, 1, 1, itemReq)
+		require.Equal(t, http.StatusPreconditionFailed, res.StatusCode)
+
+		errRes := decodeErrorResponse(t, res)
+		assert.Equal(t, CodeProductNotFound, errRes.Code)
+		assert.Equal(t, domain.ErrProductNotFound.Error(), errRes.Message)
+	})
+
+	t.Run("add cart item internal error is not exposed", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+		itemReq := AddCartItemRequest{
+			Count: 1,
+		}
+
+		tc.cartServMock.AddCartItemMock.Return(nil, errors.New("connection refused"))
+
+		res := tc.addCartItem(t, 1, 1, itemReq)
+		require.Equal(t, http.StatusInternalServerError, res.StatusCode)
+
+		errRes := decodeErrorResponse(t, res)
+		assert.Equal(t, CodeInternal, errRes.Code)
+		assert.Equal(t, internalErrorMessage, errRes.Message)
+	})
+
 	t.Run("get cart success", func(t *testing.T) {
 		t.Parallel()
 
@@ -137,12 +207,12 @@ func TestService(t *testing.T) {
 		itemOut := &domain.CartItem{
 			Sku:   skuID,
 			Name:  "Name 1",
-			Price: 100,
+			Price: rub(100),
 			Count: itemReq.Count,
 		}
 		cartOut := &domain.Cart{
 			Items:      []*domain.CartItem{itemOut},
-			TotalPrice: itemOut.Count * itemOut.Price,
+			TotalPrice: rub(int64(itemOut.Count) * itemOut.Price.Amount),
 		}
 
 		tc.cartServMock.GetCartMock.Return(cartOut, nil)
@@ -154,6 +224,301 @@ func TestService(t *testing.T) {
 		assert.Equal(t, cartOut.TotalPrice, cart.TotalPrice)
 	})
 
+	t.Run("apply promo code success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+		userID := int64(1)
+
+		cartOut := &domain.Cart{
+			Items:      []*domain.CartItem{{Sku: 1, Name: "Name 1", Price: rub(500), Count: 2}},
+			Subtotal:   rub(1000),
+			PromoCode:  "SALE10",
+			Discounts:  []domain.Discount{{PromoCode: "SALE10", Kind: domain.PromoPercentage, Amount: rub(100)}},
+			TotalPrice: rub(900),
+		}
+
+		tc.cartServMock.ApplyPromoCodeMock.When(minimock.AnyContext, userID, "SALE10").Then(cartOut, nil)
+
+		res, cartRes := tc.applyPromoCode(t, userID, `{"code":"SALE10"}`)
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		assert.Equal(t, int64(1000), cartRes.Subtotal)
+		assert.Equal(t, int64(900), cartRes.TotalPrice)
+		assert.Equal(t, domain.DefaultCurrency, cartRes.Currency)
+		assert.Equal(t, "SALE10", cartRes.PromoCode)
+		require.Len(t, cartRes.Discounts, 1)
+		assert.Equal(t, DiscountResponse{PromoCode: "SALE10", Kind: "percentage", Amount: 100}, cartRes.Discounts[0])
+	})
+
+	t.Run("apply promo code failed", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			err        error
+			wantStatus int
+			wantCode   ErrorCode
+		}{
+			{err: domain.ErrPromoNotFound, wantStatus: http.StatusNotFound, wantCode: CodePromoNotFound},
+			{err: domain.ErrPromoNotApplicable, wantStatus: http.StatusConflict, wantCode: CodePromoNotApplicable},
+		}
+
+		for _, tt := range tests {
+			tc := newTestComponentS(t)
+
+			tc.cartServMock.ApplyPromoCodeMock.Return(nil, fmt.Errorf("promoRepo.GetPromotionByCode: %w", tt.err))
+
+			res, _ := tc.applyPromoCode(t, 1, `{"code":"SALE10"}`)
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		}
+	})
+
+	t.Run("apply empty promo code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		res, _ := tc.applyPromoCode(t, 1, `{"code":""}`)
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("remove promo code success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.RemovePromoCodeMock.When(minimock.AnyContext, int64(1)).Then(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/user/1/cart/promo", nil)
+		req.SetPathValue("user_id", "1")
+		w := httptest.NewRecorder()
+
+		tc.server.RemovePromoCodeHandler(w, req)
+
+		require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+	})
+
+	t.Run("get saved items success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.GetSavedItemsMock.When(minimock.AnyContext, int64(1)).
+			Then([]*domain.CartItem{{Sku: 1, Name: "name 1", Count: 2, Price: rub(100)}}, nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/user/1/saved", nil)
+		req.SetPathValue("user_id", "1")
+		w := httptest.NewRecorder()
+
+		tc.server.GetSavedItemsHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		response := &SavedItemsResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(response))
+		require.Len(t, response.Items, 1)
+		assert.Equal(t, int64(1), response.Items[0].Sku)
+		assert.Equal(t, uint32(2), response.Items[0].Count)
+	})
+
+	t.Run("save cart item for later", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.SaveCartItemForLaterMock.When(minimock.AnyContext, int64(1), int64(2)).
+			Then(&domain.CartItem{Sku: 2, Count: 1}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/1/cart/2/save", nil)
+		req.SetPathValue("user_id", "1")
+		req.SetPathValue("sku_id", "2")
+		w := httptest.NewRecorder()
+
+		tc.server.SaveCartItemHandler(w, req)
+
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("save missing cart item for later", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.SaveCartItemForLaterMock.Return(nil, domain.ErrCartItemNotFound)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/1/cart/2/save", nil)
+		req.SetPathValue("user_id", "1")
+		req.SetPathValue("sku_id", "2")
+		w := httptest.NewRecorder()
+
+		tc.server.SaveCartItemHandler(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("move saved item to cart with out of stock", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.MoveSavedItemToCartMock.When(minimock.AnyContext, int64(1), int64(2)).
+			Then(nil, domain.ErrOutOfStock)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/1/saved/2/move-to-cart", nil)
+		req.SetPathValue("user_id", "1")
+		req.SetPathValue("sku_id", "2")
+		w := httptest.NewRecorder()
+
+		tc.server.MoveSavedItemToCartHandler(w, req)
+
+		require.Equal(t, http.StatusPreconditionFailed, w.Result().StatusCode)
+	})
+
+	t.Run("delete saved item success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.cartServMock.DeleteSavedItemMock.When(minimock.AnyContext, int64(1), int64(2)).Then(nil)
+
+		req := httptest.NewRequest(http.MethodDelete, "/user/1/saved/2", nil)
+		req.SetPathValue("user_id", "1")
+		req.SetPathValue("sku_id", "2")
+		w := httptest.NewRecorder()
+
+		tc.server.DeleteSavedItemHandler(w, req)
+
+		require.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+	})
+
+	t.Run("create guest session", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.guestSessionsMock.CreateSessionMock.Return("token", nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/guest/session", nil)
+		w := httptest.NewRecorder()
+
+		tc.server.CreateGuestSessionHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusCreated, res.StatusCode)
+
+		response := &CreateGuestSessionResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(response))
+		assert.Equal(t, "token", response.SessionToken)
+	})
+
+	t.Run("add guest cart item success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.guestSessionsMock.GetCartIDByTokenMock.When(minimock.AnyContext, "token").Then(-1, nil)
+		tc.cartServMock.AddCartItemMock.
+			When(minimock.AnyContext, int64(-1), &domain.CartItem{Sku: 2, Count: 3}).
+			Then(&domain.CartItem{Sku: 2, Count: 3}, nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/guest/cart/2", strings.NewReader(`{"count": 3}`))
+		req.SetPathValue("sku_id", "2")
+		req.Header.Set(SessionTokenHeader, "token")
+		w := httptest.NewRecorder()
+
+		tc.server.AddGuestCartItemHandler(w, req)
+
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	})
+
+	t.Run("get guest cart without session token", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/guest/cart", nil)
+		w := httptest.NewRecorder()
+
+		tc.server.GetGuestCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		errResponse := &ErrorResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(errResponse))
+		require.Len(t, errResponse.Details, 1)
+		assert.Equal(t, CodeSessionTokenNotValid, errResponse.Details[0].Code)
+	})
+
+	t.Run("get guest cart with unknown session", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.guestSessionsMock.GetCartIDByTokenMock.Return(0, domain.ErrSessionNotFound)
+
+		req := httptest.NewRequest(http.MethodGet, "/guest/cart", nil)
+		req.Header.Set(SessionTokenHeader, "unknown")
+		w := httptest.NewRecorder()
+
+		tc.server.GetGuestCartHandler(w, req)
+
+		require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	})
+
+	t.Run("merge guest cart success", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		tc.guestSessionsMock.GetCartIDByTokenMock.When(minimock.AnyContext, "token").Then(-1, nil)
+		tc.cartServMock.MergeCartsMock.When(minimock.AnyContext, int64(1), int64(-1), domain.MergeMax).
+			Then(&domain.Cart{Items: []*domain.CartItem{{Sku: 2, Count: 3}}, TotalPrice: rub(0)}, nil)
+		tc.guestSessionsMock.DeleteSessionMock.When(minimock.AnyContext, "token").Then(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/1/cart/merge",
+			strings.NewReader(`{"session_token": "token", "policy": "max"}`))
+		req.SetPathValue("user_id", "1")
+		w := httptest.NewRecorder()
+
+		tc.server.MergeCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		cartResponse := &CartResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(cartResponse))
+		require.Len(t, cartResponse.Items, 1)
+		assert.Equal(t, uint32(3), cartResponse.Items[0].Count)
+	})
+
+	t.Run("merge guest cart with unknown policy", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/1/cart/merge",
+			strings.NewReader(`{"session_token": "token", "policy": "min"}`))
+		req.SetPathValue("user_id", "1")
+		w := httptest.NewRecorder()
+
+		tc.server.MergeCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		errResponse := &ErrorResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(errResponse))
+		require.Len(t, errResponse.Details, 1)
+		assert.Equal(t, CodeMergePolicyNotValid, errResponse.Details[0].Code)
+	})
+
 	t.Run("delete cart item success", func(t *testing.T) {
 		t.Parallel()
 
@@ -229,6 +594,230 @@ func TestService(t *testing.T) {
 		_, res := tc.checkoutOrder(t, userID)
 		require.Equal(t, http.StatusInternalServerError, res.StatusCode)
 	})
+
+	t.Run("checkout cart failed: partial cart", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		userID := int64(1)
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				&domain.CartItem{Sku: 1, Count: 10, Availability: domain.ItemUnavailable},
+			},
+			Partial: true,
+		}
+
+		tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+
+		_, res := tc.checkoutOrder(t, userID)
+		require.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	})
+
+	t.Run("checkout cart failed: deleted product", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		userID := int64(1)
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				{Sku: 1, Count: 1, Price: rub(100), Availability: domain.ItemAvailable},
+				{Sku: 2, Count: 1, Availability: domain.ItemUnavailable},
+			},
+			TotalPrice: rub(100),
+		}
+
+		tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/checkout/%d", userID), nil)
+		req.SetPathValue("user_id", fmt.Sprint(userID))
+		w := httptest.NewRecorder()
+
+		tc.server.CheckoutCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusConflict, res.StatusCode)
+
+		errRes := decodeErrorResponse(t, res)
+		assert.Equal(t, CodeCartItemUnavailable, errRes.Code)
+		assert.Equal(t, domain.ErrCartItemUnavailable.Error()+": sku 2", errRes.Message)
+	})
+
+	t.Run("checkout cart failed: mixed currencies", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		userID := int64(1)
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				{Sku: 1, Count: 1, Price: rub(100)},
+				{Sku: 2, Count: 1, Price: domain.NewMoney(10, "USD")},
+			},
+			Totals:          []domain.Money{rub(100), domain.NewMoney(10, "USD")},
+			MixedCurrencies: true,
+		}
+
+		tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+
+		_, res := tc.checkoutOrder(t, userID)
+		require.Equal(t, http.StatusUnprocessableEntity, res.StatusCode)
+	})
+
+	t.Run("get cart with mixed currencies", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		userID := int64(1)
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				{Sku: 1, Count: 2, Name: "name 1", Price: rub(100)},
+				{Sku: 2, Count: 1, Name: "name 2", Price: domain.NewMoney(10, "USD")},
+			},
+			Totals:          []domain.Money{rub(200), domain.NewMoney(10, "USD")},
+			MixedCurrencies: true,
+		}
+
+		tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/user/%d/cart", userID), nil)
+		req.SetPathValue("user_id", fmt.Sprint(userID))
+		w := httptest.NewRecorder()
+
+		tc.server.GetCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		cartResponse := &CartResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(cartResponse))
+
+		assert.Equal(t, true, cartResponse.MixedCurrencies)
+		assert.Equal(t, []MoneyResponse{
+			{Amount: 200, Currency: domain.DefaultCurrency},
+			{Amount: 10, Currency: "USD"},
+		}, cartResponse.Totals)
+		require.Len(t, cartResponse.Items, 2)
+		assert.Equal(t, "USD", cartResponse.Items[1].Currency)
+	})
+
+	t.Run("get partial cart", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentS(t)
+
+		userID := int64(1)
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				{Sku: 1, Count: 2, Name: "name 1", Price: rub(100), Availability: domain.ItemStale},
+				{Sku: 2, Count: 1, Availability: domain.ItemUnavailable},
+			},
+			TotalPrice: rub(200),
+			Partial:    true,
+		}
+
+		tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/user/%d/cart", userID), nil)
+		req.SetPathValue("user_id", fmt.Sprint(userID))
+		w := httptest.NewRecorder()
+
+		tc.server.GetCartHandler(w, req)
+
+		res := w.Result()
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+
+		cartResponse := &CartResponse{}
+		require.NoError(t, json.NewDecoder(res.Body).Decode(cartResponse))
+
+		assert.Equal(t, true, cartResponse.Partial)
+		assert.Equal(t, int64(200), cartResponse.TotalPrice)
+		require.Len(t, cartResponse.Items, 2)
+		assert.Equal(t, "stale", cartResponse.Items[0].Availability)
+		assert.Equal(t, "unavailable", cartResponse.Items[1].Availability)
+	})
+
+	t.Run("checkout cart with changed price", func(t *testing.T) {
+		t.Parallel()
+
+		userID := int64(1)
+		newCart := func() *domain.Cart {
+			return &domain.Cart{
+				Items: []*domain.CartItem{
+					&domain.CartItem{Sku: 1, Count: 2, Price: rub(150), AddedPrice: rub(100), PriceChanged: true},
+				},
+				TotalPrice:   rub(300),
+				PriceChanged: true,
+			}
+		}
+
+		tests := []struct {
+			name         string
+			body         string
+			wantStatus   int
+			wantCheckout bool
+		}{
+			{name: "without confirmation", body: "", wantStatus: http.StatusConflict},
+			{name: "with outdated total", body: `{"expected_total":200}`, wantStatus: http.StatusConflict},
+			{name: "with confirmed total", body: `{"expected_total":300}`, wantStatus: http.StatusOK, wantCheckout: true},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				tc := newTestComponentS(t)
+
+				tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(newCart(), nil)
+				if tt.wantCheckout {
+					tc.orderCheckServMock.OrderCreateMock.Return(1, nil)
+					tc.cartServMock.ClearCartMock.When(minimock.AnyContext, userID).Then(nil)
+				}
+
+				req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/checkout/%d", userID), strings.NewReader(tt.body))
+				req.SetPathValue("user_id", fmt.Sprint(userID))
+				w := httptest.NewRecorder()
+
+				tc.server.CheckoutCartHandler(w, req)
+
+				res := w.Result()
+				defer res.Body.Close()
+				require.Equal(t, tt.wantStatus, res.StatusCode)
+			})
+		}
+	})
+
+	t.Run("checkout cart failed: loms errors mapped to http statuses", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			err        error
+			wantStatus int
+		}{
+			{err: domain.ErrNotEnoughStock, wantStatus: http.StatusConflict},
+			{err: domain.ErrLomsUnavailable, wantStatus: http.StatusServiceUnavailable},
+		}
+
+		for _, tt := range tests {
+			tc := newTestComponentS(t)
+
+			userID := int64(1)
+			cart := &domain.Cart{Items: []*domain.CartItem{
+				&domain.CartItem{Sku: 1, Count: 10},
+			}}
+
+			tc.cartServMock.GetCartMock.When(minimock.AnyContext, userID).Then(cart, nil)
+			tc.orderCheckServMock.OrderCreateMock.Return(0, fmt.Errorf("orderClient.OrderCreateV1: %w", tt.err))
+
+			_, res := tc.checkoutOrder(t, userID)
+			require.Equal(t, tt.wantStatus, res.StatusCode)
+		}
+	})
 }
 
 func (tc testComponentS) checkoutOrder(t *testing.T, userID int64) (int64, *http.Response) {
@@ -270,6 +859,64 @@ func (tc testComponentS) addCartItem(t *testing.T, userID, skuID int64, item Add
 	return res
 }
 
+func (tc testComponentS) applyPromoCode(t *testing.T, userID int64, body string) (*http.Response, *CartResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/user/%d/cart/promo", userID), strings.NewReader(body))
+	req.SetPathValue("user_id", fmt.Sprint(userID))
+	w := httptest.NewRecorder()
+
+	tc.server.ApplyPromoCodeHandler(w, req)
+
+	res := w.Result()
+	defer res.Body.Close()
+
+	cartRes := &CartResponse{}
+	if res.StatusCode == http.StatusOK {
+		err := json.NewDecoder(res.Body).Decode(cartRes)
+		require.NoError(t, err)
+	}
+
+	return res, cartRes
+}
+
+func TestRequestValidator_ParseStructUnmappedField(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		Comment string `json:"comment" validate:"max=3"`
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/", bytes.NewBufferString(`{"comment": "too long"}`))
+	errs := NewRequestValidator(r).
+		ParseStruct(&request{}, nil).
+		Errors()
+	require.Len(t, errs, 1)
+
+	w := httptest.NewRecorder()
+	MakeErrorResponseByErrs(context.Background(), w, errs)
+
+	res := w.Result()
+	defer res.Body.Close()
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+	errRes := decodeErrorResponse(t, res)
+	assert.Equal(t, CodeValidationFailed, errRes.Code)
+	assert.Equal(t, []FieldError{
+		{Field: "comment", Code: CodeFieldNotValid, Message: "поле comment не прошло проверку max"},
+	}, errRes.Details)
+}
+
+func decodeErrorResponse(t *testing.T, res *http.Response) *ErrorResponse {
+	t.Helper()
+
+	errRes := &ErrorResponse{}
+	err := json.NewDecoder(res.Body).Decode(errRes)
+	require.NoError(t, err)
+
+	return errRes
+}
+
 func (tc testComponentS) deleteCartItem(t *testing.T, userID, skuID int64) *http.Response {
 	t.Helper()
 
@@ -324,14 +971,14 @@ func (tc testComponentS) getCart(t *testing.T, userID int64) *domain.Cart {
 
 	cart := &domain.Cart{
 		Items:      make([]*domain.CartItem, 0, len(cartResponce.Items)),
-		TotalPrice: cartResponce.TotalPrice,
+		TotalPrice: domain.NewMoney(cartResponce.TotalPrice, cartResponce.Currency),
 	}
 	for _, itemResp := range cartResponce.Items {
 		cart.Items = append(cart.Items, &domain.CartItem{
 			Sku:   itemResp.Sku,
 			Name:  itemResp.Name,
 			Count: itemResp.Count,
-			Price: itemResp.Price,
+			Price: domain.NewMoney(itemResp.Price, itemResp.Currency),
 		})
 	}
 
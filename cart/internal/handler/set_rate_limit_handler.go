@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"route256/cart/internal/domain"
+)
+
+// LimitSetter изменяет лимит частоты запросов во время работы сервиса.
+type LimitSetter interface {
+	SetLimit(ctx context.Context, limit float64, burst int) error
+}
+
+type SetRateLimitRequest struct {
+	Limit float64 `json:"limit" validate:"required,gt=0"`
+	Burst int     `json:"burst" validate:"required,gt=0"`
+}
+
+type SetRateLimitResponse struct {
+	Limit float64 `json:"limit"`
+	Burst int     `json:"burst"`
+}
+
+// RateLimitHandler реализует HTTP-обработчики для управления лимитом запросов к внешнему сервису.
+type RateLimitHandler struct {
+	limitSetter LimitSetter
+}
+
+// NewRateLimitHandler конструктор для RateLimitHandler.
+func NewRateLimitHandler(limitSetter LimitSetter) *RateLimitHandler {
+	return &RateLimitHandler{
+		limitSetter: limitSetter,
+	}
+}
+
+// SetRateLimitHandler обрабатывает HTTP-запрос на изменение лимита и burst.
+// С backend local лимит меняется только на реплике, получившей запрос, с backend postgres - на всех репликах.
+func (h *RateLimitHandler) SetRateLimitHandler(w http.ResponseWriter, r *http.Request) {
+	fieldErrors := map[string]error{
+		"Limit": domain.ErrRateLimitNotValid,
+		"Burst": domain.ErrRateBurstNotValid,
+	}
+
+	var request SetRateLimitRequest
+	errs := NewRequestValidator(r).
+		ParseStruct(&request, fieldErrors).
+		Errors()
+	if errs != nil {
+		MakeErrorResponseByErrs(r.Context(), w, errs)
+		return
+	}
+
+	err := h.limitSetter.SetLimit(r.Context(), request.Limit, request.Burst)
+	if err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+
+	response := &SetRateLimitResponse{
+		Limit: request.Limit,
+		Burst: request.Burst,
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		MakeErrorResponse(r.Context(), w, err)
+		return
+	}
+}
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	mock "route256/cart/mocks"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimitHandler_SetRateLimitHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limit updated", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		limitSetterMock := mock.NewLimitSetterMock(mc)
+		h := NewRateLimitHandler(limitSetterMock)
+
+		limitSetterMock.SetLimitMock.
+			Inspect(func(_ context.Context, limit float64, burst int) {
+				assert.InDelta(t, 20.0, limit, 0)
+				assert.Equal(t, 5, burst)
+			}).
+			Return(nil)
+
+		res := setRateLimit(t, h, SetRateLimitRequest{Limit: 20, Burst: 5})
+		require.Equal(t, http.StatusOK, res.StatusCode)
+	})
+
+	t.Run("invalid limit and burst", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		h := NewRateLimitHandler(mock.NewLimitSetterMock(mc))
+
+		res := setRateLimit(t, h, SetRateLimitRequest{Limit: -1, Burst: 0})
+		require.Equal(t, http.StatusBadRequest, res.StatusCode)
+
+		errRes := decodeErrorResponse(t, res)
+		assert.Equal(t, CodeValidationFailed, errRes.Code)
+		require.Len(t, errRes.Details, 2)
+		assert.Equal(t, CodeRateLimitNotValid, errRes.Details[0].Code)
+		assert.Equal(t, CodeRateBurstNotValid, errRes.Details[1].Code)
+	})
+}
+
+func setRateLimit(t *testing.T, h *RateLimitHandler, request SetRateLimitRequest) *http.Response {
+	t.Helper()
+
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPut, "/admin/rate-limit/product", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	h.SetRateLimitHandler(w, req)
+
+	res := w.Result()
+	defer res.Body.Close()
+
+	return res
+}
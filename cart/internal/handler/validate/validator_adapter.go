@@ -1,6 +1,26 @@
 package validate
 
-import "github.com/go-playground/validator/v10"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError ошибка валидации поля, для которого не задана своя ошибка.
+type FieldError struct {
+	// Field имя поля в json.
+	Field string
+	// Tag название не пройденной проверки.
+	Tag string
+}
+
+// Error возвращает сообщение с именем поля и названием проверки.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("поле %s не прошло проверку %s", e.Field, e.Tag)
+}
 
 type ValidatorAdapter struct {
 	validate *validator.Validate
@@ -8,24 +28,48 @@ type ValidatorAdapter struct {
 
 // NewValidatorAdapter конструктор для ValidatorAdapter
 func NewValidatorAdapter() *ValidatorAdapter {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(jsonFieldName)
+
 	return &ValidatorAdapter{
-		validate: validator.New(),
+		validate: validate,
 	}
 }
 
-// Struct выполняет валидацию структуры с использованием ошибок для полей
+// jsonFieldName возвращает имя поля структуры в json.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
+
+// Struct выполняет валидацию структуры с использованием ошибок для полей.
+// fieldErrors сопоставляет имени поля структуры ошибку, для остальных полей возвращается *FieldError.
+// Возвращает ошибки по всем невалидным полям, объединенные через errors.Join.
 func (va *ValidatorAdapter) Struct(s any, fieldErrors map[string]error) error {
-	if err := va.validate.Struct(s); err != nil {
-		if validationErrors, ok := err.(validator.ValidationErrors); ok {
-			for _, e := range validationErrors {
-				if customErr, found := fieldErrors[e.Field()]; found {
-					return customErr
-				}
-			}
-		}
+	err := va.validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err
 	}
-	return nil
+
+	errs := make([]error, 0, len(validationErrors))
+	for _, e := range validationErrors {
+		if customErr, found := fieldErrors[e.StructField()]; found {
+			errs = append(errs, customErr)
+			continue
+		}
+		errs = append(errs, &FieldError{Field: e.Field(), Tag: e.Tag()})
+	}
+
+	return errors.Join(errs...)
 }
 
 func validateVar(va *ValidatorAdapter, s any, tag string) error {
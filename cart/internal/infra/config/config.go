@@ -14,23 +14,83 @@ type Config struct {
 	LomsService    LomsServiceConfig    `yaml:"loms_service"`
 	Jaeger         JaegerConfig         `yaml:"jaeger"`
 	RepoObserver   RepoObserverConfig   `yaml:"repo_observer"`
+	Promotions     []PromotionConfig    `yaml:"promotions"`
+	Currency       CurrencyConfig       `yaml:"currency"`
+	GuestCart      GuestCartConfig      `yaml:"guest_cart"`
+	Kafka          KafkaConfig          `yaml:"kafka"`
+	CartExpiry     CartExpiryConfig     `yaml:"cart_expiry"`
+	DB             DBConfig             `yaml:"db"`
 }
 
 // CartServiceConfig конфиг для сервиса cart.
 type CartServiceConfig struct {
 	Host                    string        `yaml:"host"`
 	Port                    string        `yaml:"port"`
+	Admin                   AdminConfig   `yaml:"admin"`
 	GracefulShutdownTimeout string        `yaml:"graceful_shutdown_timeout"`
 	Tracing                 TracingConfig `yaml:"tracing"`
 }
 
+// AdminConfig конфиг внутреннего HTTP-сервера для административных ручек.
+// Сервер должен быть доступен только из внутренней сети.
+type AdminConfig struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+}
+
+// DBConfig конфиг для БД.
+type DBConfig struct {
+	Host     string `yaml:"host"`
+	Port     int64  `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DBName   string `yaml:"db_name"`
+}
+
 // ProductServiceConfig конфиг для сервиса product.
 type ProductServiceConfig struct {
-	Protocol string `yaml:"protocol"`
-	Host     string `yaml:"host"`
-	Port     string `yaml:"port"`
-	Token    string `yaml:"token"`
-	Limit    int    `yaml:"limit"`
+	Protocol       string               `yaml:"protocol"`
+	Host           string               `yaml:"host"`
+	Port           string               `yaml:"port"`
+	Token          string               `yaml:"token"`
+	Limit          float64              `yaml:"limit"`
+	Burst          int                  `yaml:"burst"`
+	RateLimit      RateLimitConfig      `yaml:"rate_limit"`
+	Cache          ProductCacheConfig   `yaml:"cache"`
+	Batch          ProductBatchConfig   `yaml:"batch"`
+	Retry          RetryConfig          `yaml:"retry"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"`
+}
+
+// RetryConfig конфиг повторных HTTP-запросов.
+type RetryConfig struct {
+	MaxRetries    int    `yaml:"max_retries"`
+	Statuses      []int  `yaml:"statuses"`
+	BaseBackoff   string `yaml:"base_backoff"`
+	MaxBackoff    string `yaml:"max_backoff"`
+	OnError       bool   `yaml:"on_error"`
+	OnServerError bool   `yaml:"on_server_error"`
+}
+
+// RateLimitConfig конфиг хранилища состояния rate limiter.
+type RateLimitConfig struct {
+	// Backend local - лимит на каждую реплику, postgres - общий лимит всех реплик, bucket хранится в БД из DB.
+	Backend string `yaml:"backend"`
+}
+
+// ProductCacheConfig конфиг кэша товаров.
+type ProductCacheConfig struct {
+	Capacity    int    `yaml:"capacity"`
+	TTL         string `yaml:"ttl"`
+	NegativeTTL string `yaml:"negative_ttl"`
+	LoadTimeout string `yaml:"load_timeout"`
+}
+
+// ProductBatchConfig конфиг получения нескольких товаров.
+type ProductBatchConfig struct {
+	Path         string `yaml:"path"`
+	MaxBatchSize int    `yaml:"max_batch_size"`
+	MaxFanOut    int    `yaml:"max_fan_out"`
 }
 
 // JaegerConfig конфиг для jaeger.
@@ -39,16 +99,84 @@ type JaegerConfig struct {
 	Port string `yaml:"port"`
 }
 
+// defaultSampleRatio доля трейсов в выборке, если sample_ratio не задан.
+const defaultSampleRatio = 1.0
+
 // TracingConfig конфиг для трассировки.
 type TracingConfig struct {
-	ServiceName string `yaml:"service_name"`
-	Environment string `yaml:"environment"`
+	ServiceName  string   `yaml:"service_name"`
+	Environment  string   `yaml:"environment"`
+	Exporter     string   `yaml:"exporter"`
+	SampleRatio  *float64 `yaml:"sample_ratio"`
+	ParentBased  bool     `yaml:"parent_based"`
+	SampleErrors bool     `yaml:"sample_errors"`
 }
 
 // LomsServiceConfig конфиг для сервиса loms.
 type LomsServiceConfig struct {
-	Host string `yaml:"host"`
-	Port string `yaml:"port"`
+	Host           string               `yaml:"host"`
+	Port           string               `yaml:"port"`
+	Timeout        string               `yaml:"timeout"`
+	MaxRetries     int                  `yaml:"max_retries"`
+	RetryBackoff   string               `yaml:"retry_backoff"`
+	CircuitBreaker CircuitBreakerConfig `yaml:"circuit_breaker"`
+}
+
+// CircuitBreakerConfig конфиг для circuit breaker.
+type CircuitBreakerConfig struct {
+	FailureThreshold    int    `yaml:"failure_threshold"`
+	OpenTimeout         string `yaml:"open_timeout"`
+	HalfOpenMaxRequests int    `yaml:"half_open_max_requests"`
+}
+
+// PromotionConfig конфиг промокода.
+type PromotionConfig struct {
+	Code        string            `yaml:"code"`
+	Description string            `yaml:"description"`
+	Rules       []PromoRuleConfig `yaml:"rules"`
+}
+
+// PromoRuleConfig конфиг правила промокода.
+// Kind: percentage, fixed_amount, buy_n_get_m или min_total.
+type PromoRuleConfig struct {
+	Kind     string `yaml:"kind"`
+	Percent  uint32 `yaml:"percent"`
+	Amount   int64  `yaml:"amount"`
+	Sku      int64  `yaml:"sku"`
+	Buy      uint32 `yaml:"buy"`
+	Free     uint32 `yaml:"free"`
+	MinTotal int64  `yaml:"min_total"`
+}
+
+// CurrencyConfig конфиг работы с валютами корзины.
+// Mode: reject - товары в разных валютах в одной корзине запрещены,
+// convert - суммы пересчитываются в валюту Base по курсам из файла RatesFile.
+type CurrencyConfig struct {
+	Mode      string `yaml:"mode"`
+	Base      string `yaml:"base"`
+	RatesFile string `yaml:"rates_file"`
+}
+
+// GuestCartConfig конфиг гостевых корзин.
+// MergePolicy правило объединения с корзиной пользователя по умолчанию: sum, max или keep_user.
+type GuestCartConfig struct {
+	MergePolicy string `yaml:"merge_policy"`
+}
+
+// KafkaConfig конфиг для подключения к kafka.
+type KafkaConfig struct {
+	Brokers            string `yaml:"brokers"`
+	AbandonedCartTopic string `yaml:"abandoned_cart_topic"`
+}
+
+// CartExpiryConfig конфиг вытеснения неактивных корзин и событий о брошенных корзинах.
+// TTL время без изменений, после которого корзина удаляется, AbandonAfter - после которого
+// в kafka публикуется событие о брошенной корзине. Значение 0 отключает соответствующую обработку.
+type CartExpiryConfig struct {
+	TTL          string `yaml:"ttl"`
+	AbandonAfter string `yaml:"abandon_after"`
+	Period       string `yaml:"period"`
+	BatchSize    int    `yaml:"batch_size"`
 }
 
 // RepoObserverConfig конфиг для трассировки.
@@ -69,5 +197,24 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("ошибка при декодировании yaml файла-конфига: %w", err)
 	}
 
+	if err := config.Server.Tracing.setDefaults(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
+
+// setDefaults задает значения по умолчанию и проверяет настройки трассировки.
+func (c *TracingConfig) setDefaults() error {
+	if c.SampleRatio == nil {
+		sampleRatio := defaultSampleRatio
+		c.SampleRatio = &sampleRatio
+		return nil
+	}
+
+	if *c.SampleRatio < 0 || *c.SampleRatio > 1 {
+		return fmt.Errorf("sample_ratio должен быть от 0 до 1, получено %v", *c.SampleRatio)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_SampleRatio(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		yaml    string
+		want    float64
+		wantErr bool
+	}{
+		{name: "missing sample ratio defaults to 1", yaml: "service:\n  tracing:\n    service_name: cart\n", want: 1},
+		{name: "zero sample ratio is kept", yaml: "service:\n  tracing:\n    sample_ratio: 0\n", want: 0},
+		{name: "sample ratio in range", yaml: "service:\n  tracing:\n    sample_ratio: 0.25\n", want: 0.25},
+		{name: "negative sample ratio", yaml: "service:\n  tracing:\n    sample_ratio: -0.1\n", wantErr: true},
+		{name: "sample ratio above 1", yaml: "service:\n  tracing:\n    sample_ratio: 1.5\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "values.yaml")
+			require.NoError(t, os.WriteFile(filename, []byte(tt.yaml), 0o600))
+
+			cfg, err := LoadConfig(filename)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, cfg.Server.Tracing.SampleRatio)
+			assert.InDelta(t, tt.want, *cfg.Server.Tracing.SampleRatio, 0)
+		})
+	}
+}
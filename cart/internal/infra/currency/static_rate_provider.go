@@ -0,0 +1,90 @@
+package currency
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"route256/cart/internal/domain"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ratesFile формат файла с курсами валют.
+// Rates задает количество основных единиц валюты Base за основную единицу валюты, например "92.5" или "185/2".
+type ratesFile struct {
+	Base  string            `yaml:"base"`
+	Rates map[string]string `yaml:"rates"`
+}
+
+// StaticRateProvider отдает курсы валют, загруженные из файла при создании.
+type StaticRateProvider struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewStaticRateProvider загружает курсы валют из yaml-файла path.
+func NewStaticRateProvider(path string) (*StaticRateProvider, error) {
+	f, err := os.Open(path) // nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+
+	file := &ratesFile{}
+	if err := yaml.NewDecoder(f).Decode(file); err != nil {
+		return nil, fmt.Errorf("yaml.Decode: %w", err)
+	}
+
+	if file.Base == "" {
+		return nil, fmt.Errorf("base currency is not set in %s", path)
+	}
+
+	p := &StaticRateProvider{
+		base:  strings.ToUpper(file.Base),
+		rates: make(map[string]*big.Rat, len(file.Rates)),
+	}
+	for currency, value := range file.Rates {
+		rate, ok := new(big.Rat).SetString(value)
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("invalid rate %q for currency %s", value, currency)
+		}
+
+		p.rates[strings.ToUpper(currency)] = rate
+	}
+
+	return p, nil
+}
+
+// Rate возвращает количество основных единиц валюты to за основную единицу валюты from.
+// Курс между двумя небазовыми валютами считается через базовую. Разницу в минимальных единицах валют
+// учитывает domain.Money.Convert.
+func (p *StaticRateProvider) Rate(_ context.Context, from, to string) (*big.Rat, error) {
+	fromRate, err := p.baseRate(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toRate, err := p.baseRate(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Rat).Quo(fromRate, toRate), nil
+}
+
+// baseRate возвращает количество единиц базовой валюты за единицу валюты currency.
+func (p *StaticRateProvider) baseRate(currency string) (*big.Rat, error) {
+	if currency == p.base {
+		return big.NewRat(1, 1), nil
+	}
+
+	rate, ok := p.rates[currency]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domain.ErrCurrencyRateNotFound, currency)
+	}
+
+	return rate, nil
+}
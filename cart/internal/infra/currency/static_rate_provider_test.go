@@ -0,0 +1,60 @@
+package currency
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"route256/cart/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticRateProvider(t *testing.T) {
+	t.Parallel()
+
+	provider, err := NewStaticRateProvider("testdata/rates.yaml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want *big.Rat
+	}{
+		{name: "to base", from: "USD", to: "RUB", want: big.NewRat(185, 2)},
+		{name: "from base", from: "RUB", to: "EUR", want: big.NewRat(1, 100)},
+		{name: "cross rate", from: "USD", to: "EUR", want: big.NewRat(37, 40)},
+		{name: "same currency", from: "USD", to: "USD", want: big.NewRat(1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rate, err := provider.Rate(context.Background(), tt.from, tt.to)
+			require.NoError(t, err)
+			assert.Zero(t, tt.want.Cmp(rate), "got %s", rate.RatString())
+		})
+	}
+
+	t.Run("convert USD to JPY", func(t *testing.T) {
+		t.Parallel()
+
+		rate, err := provider.Rate(context.Background(), "USD", "JPY")
+		require.NoError(t, err)
+
+		// 10.00 USD по курсу 92.5 / 0.6 = 154.1(6) JPY за USD, у иены нет минимальных единиц.
+		converted, err := domain.NewMoney(1000, "USD").Convert(rate, "JPY")
+		require.NoError(t, err)
+		assert.Equal(t, domain.NewMoney(1542, "JPY"), converted)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := provider.Rate(context.Background(), "GBP", "RUB")
+		require.ErrorIs(t, err, domain.ErrCurrencyRateNotFound)
+	})
+}
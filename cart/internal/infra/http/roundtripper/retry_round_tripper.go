@@ -1,8 +1,20 @@
 package roundtripper
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
+	"time"
+
+	"route256/cart/pkg/circuitbreaker"
+)
+
+const (
+	defaultBaseBackoff = 100 * time.Millisecond
+	defaultMaxBackoff  = 2 * time.Second
 )
 
 // RetryRoundTripper - http.RoundTripper (middleware) с поддержкой повторных попыток.
@@ -10,29 +22,87 @@ type RetryRoundTripper struct {
 	rt                http.RoundTripper
 	triggeredStatuses map[int]struct{}
 	maxRetries        int
+
+	baseBackoff        time.Duration
+	maxBackoff         time.Duration
+	retryOnError       bool
+	retryOnServerError bool
+	breaker            *circuitbreaker.Breaker
+}
+
+// RetryOption настраивает RetryRoundTripper.
+type RetryOption func(*RetryRoundTripper)
+
+// WithBackoff задает начальную и максимальную задержку между попытками.
+// Задержка удваивается с каждой попыткой и рандомизируется (jitter).
+func WithBackoff(base, maxBackoff time.Duration) RetryOption {
+	return func(l *RetryRoundTripper) {
+		l.baseBackoff = base
+		l.maxBackoff = maxBackoff
+	}
+}
+
+// WithRetryOnError включает повторные попытки при сетевых ошибках.
+func WithRetryOnError() RetryOption {
+	return func(l *RetryRoundTripper) {
+		l.retryOnError = true
+	}
+}
+
+// WithRetryOnServerError включает повторные попытки при ответах 5xx.
+func WithRetryOnServerError() RetryOption {
+	return func(l *RetryRoundTripper) {
+		l.retryOnServerError = true
+	}
+}
+
+// WithCircuitBreaker включает circuit breaker: при недоступности ресурса запросы сразу завершаются ошибкой.
+// Отказом считаются сетевые ошибки и ответы 5xx.
+func WithCircuitBreaker(breaker *circuitbreaker.Breaker) RetryOption {
+	return func(l *RetryRoundTripper) {
+		l.breaker = breaker
+	}
 }
 
 // NewRetryRoundTripper создает http.RoundTripper с поддержкой повторных попыток.
 // Повторная попытка выполняется, если код ответа содержится в triggerStatuses.
-func NewRetryRoundTripper(rt http.RoundTripper, triggerStatuses []int, maxRetries int) http.RoundTripper {
+func NewRetryRoundTripper(rt http.RoundTripper, triggerStatuses []int, maxRetries int, opts ...RetryOption) http.RoundTripper {
 	l := &RetryRoundTripper{
 		rt:                rt,
 		triggeredStatuses: make(map[int]struct{}, len(triggerStatuses)),
 		maxRetries:        maxRetries,
+		baseBackoff:       defaultBaseBackoff,
+		maxBackoff:        defaultMaxBackoff,
 	}
 
 	for _, status := range triggerStatuses {
 		l.triggeredStatuses[status] = struct{}{}
 	}
 
+	for _, opt := range opts {
+		opt(l)
+	}
+
 	return l
 }
 
 func (l *RetryRoundTripper) isTriggeredStatus(statusCode int) bool {
+	if l.retryOnServerError && statusCode >= http.StatusInternalServerError {
+		return true
+	}
+
 	_, ok := l.triggeredStatuses[statusCode]
 	return ok
 }
 
+func (l *RetryRoundTripper) shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return l.retryOnError && !errors.Is(err, circuitbreaker.ErrOpen)
+	}
+
+	return l.isTriggeredStatus(resp.StatusCode)
+}
+
 func copyRequest(r *http.Request) (*http.Request, error) {
 	rCopy := r
 	if r.Body != nil && r.GetBody != nil {
@@ -48,33 +118,121 @@ func copyRequest(r *http.Request) (*http.Request, error) {
 	return rCopy, nil
 }
 
+// RoundTrip выполняет запрос с повторными попытками. Повторные попытки прекращаются, если Retry-After
+// больше максимальной задержки или задержка не укладывается в дедлайн контекста запроса:
+// в этом случае возвращается результат последней попытки.
 func (l *RetryRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
-	rCopy, errCopy := copyRequest(r)
-	if errCopy != nil {
-		return nil, fmt.Errorf("copyRequest: %w", errCopy)
+	resp, err := l.roundTrip(r)
+
+	for attempt := 1; attempt <= l.maxRetries && l.shouldRetry(resp, err); attempt++ {
+		delay, ok := l.retryDelay(r.Context(), resp, attempt)
+		if !ok {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		if sleepErr := sleep(r.Context(), delay); sleepErr != nil {
+			return nil, fmt.Errorf("sleep: %w", sleepErr)
+		}
+
+		resp, err = l.roundTrip(r)
+	}
+
+	return resp, err
+}
+
+func (l *RetryRoundTripper) roundTrip(r *http.Request) (*http.Response, error) {
+	if l.breaker != nil {
+		if err := l.breaker.Allow(); err != nil {
+			return nil, fmt.Errorf("breaker.Allow: %w", err)
+		}
+	}
+
+	rCopy, err := copyRequest(r)
+	if err != nil {
+		if l.breaker != nil {
+			l.breaker.Release()
+		}
+		return nil, fmt.Errorf("copyRequest: %w", err)
 	}
 
 	resp, err := l.rt.RoundTrip(rCopy)
+
+	if l.breaker != nil {
+		failed := (err != nil && r.Context().Err() == nil) ||
+			(err == nil && resp.StatusCode >= http.StatusInternalServerError)
+		l.breaker.Done(!failed)
+	}
+
 	if err != nil {
 		return resp, fmt.Errorf("l.rt.RoundTrip: %w", err)
 	}
 
-	curRetry := 1
-	for l.isTriggeredStatus(resp.StatusCode) && curRetry <= l.maxRetries {
-		resp.Body.Close()
+	return resp, nil
+}
 
-		rCopy, errCopy = copyRequest(r)
-		if errCopy != nil {
-			return nil, fmt.Errorf("copyRequest: %w", errCopy)
+// retryDelay возвращает задержку перед попыткой attempt. Задержка берется из заголовка Retry-After,
+// если он есть, иначе вычисляется backoff. Возвращает false, если Retry-After больше максимальной задержки
+// или задержка не укладывается в дедлайн ctx.
+func (l *RetryRoundTripper) retryDelay(ctx context.Context, resp *http.Response, attempt int) (time.Duration, bool) {
+	delay := l.backoff(attempt)
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > l.maxBackoff {
+				return 0, false
+			}
+			delay = retryAfter
 		}
+	}
 
-		resp, err = l.rt.RoundTrip(rCopy)
-		if err != nil {
-			return resp, fmt.Errorf("l.rt.RoundTrip: %w", err)
-		}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		return 0, false
+	}
 
-		curRetry++
+	return delay, true
+}
+
+// backoff возвращает задержку перед попыткой attempt: экспоненциальный рост с jitter в пределах [d/2, d].
+func (l *RetryRoundTripper) backoff(attempt int) time.Duration {
+	d := l.baseBackoff << (attempt - 1)
+	if d <= 0 || d > l.maxBackoff {
+		d = l.maxBackoff
+	}
+	if d <= 0 {
+		return 0
 	}
 
-	return resp, err
+	half := d / 2
+	return half + rand.N(d-half+1) // nolint:gosec // криптостойкость для jitter не нужна
+}
+
+// parseRetryAfter разбирает заголовок Retry-After в секундах или в формате HTTP-даты.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
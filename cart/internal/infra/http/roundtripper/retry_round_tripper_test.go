@@ -2,11 +2,18 @@ package roundtripper
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
+	"route256/cart/pkg/circuitbreaker"
+
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 )
 
@@ -115,3 +122,231 @@ func TestRetryRoundTripper_RoundTripSuccessOnSecondCallButZeroRetries(t *testing
 		t.Errorf("expected 420, got %d", resp.StatusCode)
 	}
 }
+
+func TestRetryRoundTripper_RoundTripRetryOnError(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	resp200 := &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(bytes.NewBufferString("ok")),
+	}
+
+	mockRoundTripper.
+		On("RoundTrip", mock.Anything).
+		Return((*http.Response)(nil), errors.New("connection refused")).Once()
+	mockRoundTripper.
+		On("RoundTrip", mock.Anything).
+		Return(resp200, nil).Once()
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, nil, 3,
+		WithBackoff(time.Millisecond, time.Millisecond),
+		WithRetryOnError(),
+	)
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	resp, err := retryRT.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 2)
+}
+
+func TestRetryRoundTripper_RoundTripErrorWithoutRetryOnError(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	mockRoundTripper.
+		On("RoundTrip", mock.Anything).
+		Return((*http.Response)(nil), errors.New("connection refused"))
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, []int{420}, 3,
+		WithBackoff(time.Millisecond, time.Millisecond),
+	)
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	_, err := retryRT.RoundTrip(req)
+	require.Error(t, err)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 1)
+}
+
+func TestRetryRoundTripper_RoundTripRetryOnServerError(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	resp503 := &http.Response{
+		StatusCode: 503,
+		Body:       io.NopCloser(bytes.NewBufferString("")),
+	}
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(resp503, nil)
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, nil, 2,
+		WithBackoff(time.Millisecond, time.Millisecond),
+		WithRetryOnServerError(),
+	)
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	resp, err := retryRT.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 3)
+}
+
+func TestRetryRoundTripper_RoundTripContextCanceledWhileWaiting(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	resp429 := &http.Response{
+		StatusCode: 429,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+		Body:       io.NopCloser(bytes.NewBufferString("")),
+	}
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(resp429, nil)
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, []int{429}, 3)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+	start := time.Now()
+	_, err := retryRT.RoundTrip(req)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 1)
+}
+
+func TestRetryRoundTripper_RoundTripRetryAfterExceedsMaxBackoff(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	resp503 := &http.Response{
+		StatusCode: 503,
+		Header:     http.Header{"Retry-After": []string{"60"}},
+		Body:       io.NopCloser(bytes.NewBufferString("")),
+	}
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(resp503, nil)
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, []int{503}, 3, WithBackoff(time.Millisecond, time.Second))
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	start := time.Now()
+	resp, err := retryRT.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode)
+	assert.Less(t, time.Since(start), time.Second)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 1)
+}
+
+func TestRetryRoundTripper_RoundTripRetryAfterExceedsDeadline(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	resp429 := &http.Response{
+		StatusCode: 429,
+		Header:     http.Header{"Retry-After": []string{"1"}},
+		Body:       io.NopCloser(bytes.NewBufferString("")),
+	}
+
+	mockRoundTripper.On("RoundTrip", mock.Anything).Return(resp429, nil)
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, []int{429}, 3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", "http://example.com", nil)
+	resp, err := retryRT.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 429, resp.StatusCode)
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 1)
+}
+
+func TestRetryRoundTripper_RoundTripCopyRequestErrorReleasesBreaker(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	breaker := circuitbreaker.New("test_retry_round_tripper_copy", circuitbreaker.Config{
+		FailureThreshold: 1,
+		OpenTimeout:      time.Millisecond,
+	})
+	require.NoError(t, breaker.Allow())
+	breaker.Done(false)
+	time.Sleep(2 * time.Millisecond)
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, nil, 0, WithCircuitBreaker(breaker))
+
+	req, _ := http.NewRequest("POST", "http://example.com", bytes.NewBufferString("body"))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return nil, errors.New("body is gone")
+	}
+	_, err := retryRT.RoundTrip(req)
+	require.Error(t, err)
+	assert.Equal(t, circuitbreaker.HalfOpen, breaker.State())
+	assert.NoError(t, breaker.Allow(), "half-open slot is released")
+	mockRoundTripper.AssertNotCalled(t, "RoundTrip", mock.Anything)
+}
+
+func TestRetryRoundTripper_RoundTripCircuitBreakerOpen(t *testing.T) {
+	t.Parallel()
+
+	mockRoundTripper := new(MockRoundTripper)
+
+	mockRoundTripper.
+		On("RoundTrip", mock.Anything).
+		Return((*http.Response)(nil), errors.New("connection refused"))
+
+	breaker := circuitbreaker.New("test_retry_round_tripper", circuitbreaker.Config{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+	})
+
+	retryRT := NewRetryRoundTripper(mockRoundTripper, nil, 5,
+		WithBackoff(time.Millisecond, time.Millisecond),
+		WithRetryOnError(),
+		WithCircuitBreaker(breaker),
+	)
+
+	req, _ := http.NewRequest("GET", "http://example.com", nil)
+	_, err := retryRT.RoundTrip(req)
+	require.ErrorIs(t, err, circuitbreaker.ErrOpen)
+	assert.Equal(t, circuitbreaker.Open, breaker.State())
+	mockRoundTripper.AssertNumberOfCalls(t, "RoundTrip", 2)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "3", want: 3 * time.Second, wantOK: true},
+		{name: "date in past", value: "Wed, 21 Oct 2015 07:28:00 GMT", want: 0, wantOK: true},
+		{name: "invalid", value: "soon"},
+		{name: "negative", value: "-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseRetryAfter(tt.value)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package kafka
+
+// AbandonedCartEventKafka описывает событие о брошенной корзине для передачи в kafka.
+type AbandonedCartEventKafka struct {
+	EventID   string                   `json:"event_id"`
+	UserID    int64                    `json:"user_id"`
+	Items     []AbandonedCartItemKafka `json:"items"`
+	UpdatedAt string                   `json:"updated_at"`
+}
+
+// AbandonedCartItemKafka описывает товар брошенной корзины.
+type AbandonedCartItemKafka struct {
+	Sku   int64  `json:"sku"`
+	Count uint32 `json:"count"`
+}
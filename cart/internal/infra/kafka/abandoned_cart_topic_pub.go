@@ -0,0 +1,119 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/tracer"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AbandonedCartTopicKafka реализует публикацию событий о брошенных корзинах в Kafka.
+type AbandonedCartTopicKafka struct {
+	producer sarama.SyncProducer
+	topic    string
+	tm       *tracer.Manager
+}
+
+// NewAbandonedCartTopicKafka создает новый экземпляр AbandonedCartTopicKafka.
+func NewAbandonedCartTopicKafka(brokers []string, topic string, tm *tracer.Manager) (*AbandonedCartTopicKafka, error) {
+	a := &AbandonedCartTopicKafka{
+		topic: topic,
+		tm:    tm,
+	}
+
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = false
+	config.Producer.Return.Successes = true
+
+	var err error
+	a.producer, err = sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("sarama.NewSyncProducer: %w", err)
+	}
+
+	return a, nil
+}
+
+// Send публикует сообщение в Kafka с заданным ключом и событием о брошенной корзине.
+// Контекст трассировки передается в заголовках W3C Trace Context.
+func (a *AbandonedCartTopicKafka) Send(key string, value *domain.AbandonedCartEvent) error {
+	ctx, span := a.tm.Tracer.Start(context.Background(), fmt.Sprintf("send %s", a.topic),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(a.topic),
+			semconv.MessagingKafkaMessageKey(key),
+		),
+	)
+	defer span.End()
+
+	err := a.send(ctx, key, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+// Close закрывает продюсера Kafka.
+func (a *AbandonedCartTopicKafka) Close() error {
+	return a.producer.Close()
+}
+
+func (a *AbandonedCartTopicKafka) send(ctx context.Context, key string, value *domain.AbandonedCartEvent) error {
+	items := make([]AbandonedCartItemKafka, 0, len(value.Items))
+	for _, item := range value.Items {
+		items = append(items, AbandonedCartItemKafka{
+			Sku:   item.Sku,
+			Count: item.Count,
+		})
+	}
+
+	valueBytes, err := json.Marshal(&AbandonedCartEventKafka{
+		EventID:   value.EventID,
+		UserID:    value.UserID,
+		Items:     items,
+		UpdatedAt: value.UpdatedAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return err
+	}
+
+	traceHeaders := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, traceHeaders)
+
+	headers := make([]sarama.RecordHeader, 0, len(traceHeaders))
+	for k, v := range traceHeaders {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(v),
+		})
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic:   a.topic,
+		Key:     sarama.StringEncoder(key),
+		Value:   sarama.ByteEncoder(valueBytes),
+		Headers: headers,
+	}
+
+	_, _, err = a.producer.SendMessage(msg)
+	if err != nil {
+		return fmt.Errorf("producer.SendMessage: %w", err)
+	}
+
+	return nil
+}
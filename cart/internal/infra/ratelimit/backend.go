@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInvalidLimit возвращается при попытке задать неположительный лимит или burst.
+var ErrInvalidLimit = errors.New("limit and burst must be positive")
+
+// Backend хранит состояние token bucket.
+// LocalBackend ограничивает запросы одной реплики, PostgresBackend хранит bucket во внешнем хранилище
+// и ограничивает суммарную частоту запросов всех реплик.
+type Backend interface {
+	// Reserve забирает токен и возвращает время, через которое его можно использовать.
+	Reserve(ctx context.Context, now time.Time) (time.Duration, error)
+	// Cancel возвращает токен, зарезервированный Reserve, если он не был использован.
+	Cancel(ctx context.Context, now time.Time)
+	// SetLimit изменяет лимит (запросов в секунду) и burst.
+	SetLimit(ctx context.Context, limit float64, burst int) error
+	// Limit возвращает текущий лимит (запросов в секунду), который соблюдает bucket.
+	Limit() float64
+}
+
+// LocalBackend хранит token bucket в памяти процесса.
+type LocalBackend struct {
+	mu     sync.Mutex
+	bucket bucket
+}
+
+// NewLocalBackend создает LocalBackend с заполненным bucket.
+func NewLocalBackend(limit float64, burst int) (*LocalBackend, error) {
+	if limit <= 0 || burst <= 0 {
+		return nil, ErrInvalidLimit
+	}
+
+	return &LocalBackend{
+		bucket: bucket{
+			limit:  limit,
+			burst:  burst,
+			tokens: float64(burst),
+		},
+	}, nil
+}
+
+// Reserve забирает токен. Если токенов нет, bucket уходит в долг и возвращается время ожидания.
+func (b *LocalBackend) Reserve(_ context.Context, now time.Time) (time.Duration, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.bucket.reserve(now), nil
+}
+
+// Cancel возвращает токен в bucket.
+func (b *LocalBackend) Cancel(_ context.Context, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bucket.cancel(now)
+}
+
+// SetLimit изменяет лимит и burst. Накопленные токены сохраняются в пределах нового burst.
+func (b *LocalBackend) SetLimit(_ context.Context, limit float64, burst int) error {
+	if limit <= 0 || burst <= 0 {
+		return ErrInvalidLimit
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bucket.setLimit(time.Now(), limit, burst)
+
+	return nil
+}
+
+// Limit возвращает текущий лимит bucket.
+func (b *LocalBackend) Limit() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.bucket.limit
+}
+
+// bucket состояние token bucket. Синхронизация доступа лежит на Backend.
+type bucket struct {
+	limit  float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) reserve(now time.Time) time.Duration {
+	b.refill(now)
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.limit * float64(time.Second))
+}
+
+func (b *bucket) cancel(now time.Time) {
+	b.refill(now)
+	b.tokens = min(b.tokens+1, float64(b.burst))
+}
+
+func (b *bucket) setLimit(now time.Time, limit float64, burst int) {
+	b.refill(now)
+	b.limit = limit
+	b.burst = burst
+	b.tokens = min(b.tokens, float64(burst))
+}
+
+func (b *bucket) refill(now time.Time) {
+	if b.last.IsZero() {
+		b.last = now
+		return
+	}
+
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = min(b.tokens+elapsed.Seconds()*b.limit, float64(b.burst))
+		b.last = now
+	}
+}
@@ -0,0 +1,141 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	sqlcrepos "route256/cart/internal/infra/ratelimit/sqlc/generated"
+	"route256/cart/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresBackend хранит token bucket в postgres, поэтому состояние bucket общее для всех реплик сервиса.
+// Каждое обращение к bucket выполняется в транзакции с блокировкой строки, а время берется из часов postgres,
+// чтобы расхождение часов реплик не влияло на лимит. Изменение лимита через SetLimit действует на всех репликах.
+type PostgresBackend struct {
+	pool *pgxpool.Pool
+	name string
+	// limit последний прочитанный из postgres лимит (биты float64).
+	limit atomic.Uint64
+}
+
+// NewPostgresBackend создает PostgresBackend для bucket с именем name. Если bucket еще не создан,
+// он создается с лимитом limit и burst, иначе используется лимит, уже сохраненный в postgres.
+func NewPostgresBackend(ctx context.Context, pool *pgxpool.Pool, name string, limit float64, burst int) (*PostgresBackend, error) {
+	if limit <= 0 || burst <= 0 || burst > math.MaxInt32 {
+		return nil, ErrInvalidLimit
+	}
+
+	b := &PostgresBackend{
+		pool: pool,
+		name: name,
+	}
+
+	err := sqlcrepos.New(pool).CreateRateLimitBucket(ctx, &sqlcrepos.CreateRateLimitBucketParams{
+		Name:      name,
+		RateLimit: limit,
+		Burst:     int32(burst), //nolint:gosec // G115: burst проверен выше
+		Tokens:    float64(burst),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querier.CreateRateLimitBucket: %w", err)
+	}
+
+	err = b.update(ctx, func(bkt *bucket, now time.Time) {
+		bkt.refill(now)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Reserve забирает токен из общего bucket. Если токенов нет, bucket уходит в долг и возвращается время ожидания.
+func (b *PostgresBackend) Reserve(ctx context.Context, _ time.Time) (time.Duration, error) {
+	var wait time.Duration
+	err := b.update(ctx, func(bkt *bucket, now time.Time) {
+		wait = bkt.reserve(now)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return wait, nil
+}
+
+// Cancel возвращает токен в общий bucket.
+func (b *PostgresBackend) Cancel(ctx context.Context, _ time.Time) {
+	err := b.update(ctx, func(bkt *bucket, now time.Time) {
+		bkt.cancel(now)
+	})
+	if err != nil {
+		logger.Warnw("failed to return rate limit token", "bucket", b.name, "err", err)
+	}
+}
+
+// SetLimit изменяет лимит и burst общего bucket для всех реплик.
+// Накопленные токены сохраняются в пределах нового burst.
+func (b *PostgresBackend) SetLimit(ctx context.Context, limit float64, burst int) error {
+	if limit <= 0 || burst <= 0 || burst > math.MaxInt32 {
+		return ErrInvalidLimit
+	}
+
+	return b.update(ctx, func(bkt *bucket, now time.Time) {
+		bkt.setLimit(now, limit, burst)
+	})
+}
+
+// Limit возвращает общий лимит всех реплик, прочитанный из postgres при последнем обращении к bucket.
+func (b *PostgresBackend) Limit() float64 {
+	return math.Float64frombits(b.limit.Load())
+}
+
+// update читает bucket с блокировкой строки, применяет к нему fn и сохраняет результат в той же транзакции.
+func (b *PostgresBackend) update(ctx context.Context, fn func(bkt *bucket, now time.Time)) error {
+	var limit float64
+	err := pgx.BeginFunc(ctx, b.pool, func(tx pgx.Tx) error {
+		querier := sqlcrepos.New(tx)
+
+		row, err := querier.GetRateLimitBucketForUpdate(ctx, b.name)
+		if err != nil {
+			return fmt.Errorf("querier.GetRateLimitBucketForUpdate: %w", err)
+		}
+
+		bkt := &bucket{
+			limit:  row.RateLimit,
+			burst:  int(row.Burst),
+			tokens: row.Tokens,
+			last:   row.UpdatedAt.Time,
+		}
+		fn(bkt, row.Now.Time)
+
+		err = querier.UpdateRateLimitBucket(ctx, &sqlcrepos.UpdateRateLimitBucketParams{
+			Name:      b.name,
+			RateLimit: bkt.limit,
+			Burst:     int32(bkt.burst), //nolint:gosec // G115: burst проверен при сохранении
+			Tokens:    bkt.tokens,
+			UpdatedAt: pgtype.Timestamptz{Time: bkt.last, Valid: true},
+		})
+		if err != nil {
+			return fmt.Errorf("querier.UpdateRateLimitBucket: %w", err)
+		}
+
+		limit = bkt.limit
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("pgx.BeginFunc: %w", err)
+	}
+
+	b.limit.Store(math.Float64bits(limit))
+
+	return nil
+}
@@ -0,0 +1,18 @@
+-- name: CreateRateLimitBucket :exec
+insert into rate_limit_buckets(name, rate_limit, burst, tokens, updated_at)
+values ($1, $2, $3, $4, clock_timestamp())
+on conflict (name) do nothing;
+
+-- name: GetRateLimitBucketForUpdate :one
+select rate_limit, burst, tokens, updated_at, clock_timestamp()::timestamptz as now
+from rate_limit_buckets
+where name = $1
+for update;
+
+-- name: UpdateRateLimitBucket :exec
+update rate_limit_buckets
+set rate_limit = $2,
+    burst      = $3,
+    tokens     = $4,
+    updated_at = $5
+where name = $1;
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"route256/cart/pkg/metrics"
+)
+
+// ErrWaitExceedsDeadline возвращается, если токен не освободится до дедлайна контекста.
+var ErrWaitExceedsDeadline = errors.New("rate limiter wait exceeds context deadline")
+
+// TokenBucketRateLimiter ограничивает частоту запросов алгоритмом token bucket.
+type TokenBucketRateLimiter struct {
+	name    string
+	backend Backend
+}
+
+// NewTokenBucketRateLimiter создает TokenBucketRateLimiter. name используется как метка метрик.
+func NewTokenBucketRateLimiter(name string, backend Backend) *TokenBucketRateLimiter {
+	metrics.StoreRateLimiterLimit(name, backend.Limit())
+
+	return &TokenBucketRateLimiter{
+		name:    name,
+		backend: backend,
+	}
+}
+
+// Acquire блокирует до получения токена или отмены контекста.
+func (l *TokenBucketRateLimiter) Acquire(ctx context.Context) error {
+	start := time.Now()
+
+	wait, err := l.backend.Reserve(ctx, start)
+	if err != nil {
+		metrics.AddRateLimiterWaitHist(l.name, "error", time.Since(start))
+		return fmt.Errorf("backend.Reserve: %w", err)
+	}
+	// Общий лимит могла изменить другая реплика.
+	metrics.StoreRateLimiterLimit(l.name, l.backend.Limit())
+
+	if wait == 0 {
+		metrics.AddRateLimiterWaitHist(l.name, "ok", 0)
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && start.Add(wait).After(deadline) {
+		l.backend.Cancel(ctx, time.Now())
+		metrics.AddRateLimiterWaitHist(l.name, "deadline", time.Since(start))
+		return ErrWaitExceedsDeadline
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		l.backend.Cancel(context.WithoutCancel(ctx), time.Now())
+		metrics.AddRateLimiterWaitHist(l.name, "canceled", time.Since(start))
+		return ctx.Err()
+	case <-timer.C:
+		metrics.AddRateLimiterWaitHist(l.name, "ok", time.Since(start))
+		return nil
+	}
+}
+
+// SetLimit изменяет лимит (запросов в секунду) и burst во время работы.
+func (l *TokenBucketRateLimiter) SetLimit(ctx context.Context, limit float64, burst int) error {
+	if err := l.backend.SetLimit(ctx, limit, burst); err != nil {
+		return fmt.Errorf("backend.SetLimit: %w", err)
+	}
+
+	metrics.StoreRateLimiterLimit(l.name, l.backend.Limit())
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestLocalBackend_Reserve(t *testing.T) {
+	t.Parallel()
+
+	backend, err := NewLocalBackend(10, 2)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	for range 2 {
+		wait, err := backend.Reserve(context.Background(), now)
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+
+	wait, err := backend.Reserve(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 100*time.Millisecond, wait)
+
+	wait, err = backend.Reserve(context.Background(), now.Add(time.Second))
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestLocalBackend_Cancel(t *testing.T) {
+	t.Parallel()
+
+	backend, err := NewLocalBackend(10, 1)
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	_, err = backend.Reserve(context.Background(), now)
+	require.NoError(t, err)
+	backend.Cancel(context.Background(), now)
+
+	wait, err := backend.Reserve(context.Background(), now)
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestLocalBackend_SetLimit(t *testing.T) {
+	t.Parallel()
+
+	backend, err := NewLocalBackend(10, 1)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, backend.SetLimit(context.Background(), 0, 1), ErrInvalidLimit)
+	require.NoError(t, backend.SetLimit(context.Background(), 1, 1))
+
+	now := time.Now()
+
+	_, err = backend.Reserve(context.Background(), now)
+	require.NoError(t, err)
+
+	wait, err := backend.Reserve(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, wait)
+}
+
+func TestTokenBucketRateLimiter_Acquire(t *testing.T) {
+	t.Parallel()
+
+	t.Run("token available", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLocalBackend(1000, 1)
+		require.NoError(t, err)
+		limiter := NewTokenBucketRateLimiter("test", backend)
+
+		require.NoError(t, limiter.Acquire(context.Background()))
+		require.NoError(t, limiter.Acquire(context.Background()))
+	})
+
+	t.Run("context canceled while waiting", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLocalBackend(0.1, 1)
+		require.NoError(t, err)
+		limiter := NewTokenBucketRateLimiter("test", backend)
+
+		require.NoError(t, limiter.Acquire(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err = limiter.Acquire(ctx)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("wait exceeds deadline", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLocalBackend(0.1, 1)
+		require.NoError(t, err)
+		limiter := NewTokenBucketRateLimiter("test", backend)
+
+		require.NoError(t, limiter.Acquire(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		start := time.Now()
+		err = limiter.Acquire(ctx)
+		require.ErrorIs(t, err, ErrWaitExceedsDeadline)
+		assert.Less(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("limit updated at runtime", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLocalBackend(0.1, 1)
+		require.NoError(t, err)
+		limiter := NewTokenBucketRateLimiter("test", backend)
+
+		require.NoError(t, limiter.Acquire(context.Background()))
+		require.NoError(t, limiter.SetLimit(context.Background(), 1000, 1))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		require.NoError(t, limiter.Acquire(ctx))
+	})
+}
@@ -1,10 +1,19 @@
 package repository
 
-import "route256/cart/internal/domain"
+import (
+	"route256/cart/internal/domain"
+	"time"
+)
 
 // CartEntity хранит данные о корзине.
 // Используется только в репозитории для быстрого доступа к товарам в корзине.
 type CartEntity struct {
-	Items      map[int64]*domain.CartItem
-	TotalPrice uint32
+	Items     map[int64]*domain.CartItem
+	PromoCode string
+	// SavedItems товары, отложенные пользователем на потом. Не очищаются вместе с корзиной.
+	SavedItems map[int64]*domain.CartItem
+	// UpdatedAt время последнего изменения корзины.
+	UpdatedAt time.Time
+	// AbandonedNotified признак того, что событие о брошенной корзине уже создано после последнего изменения.
+	AbandonedNotified bool
 }
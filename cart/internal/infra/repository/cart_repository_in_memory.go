@@ -6,6 +6,9 @@ import (
 	"route256/cart/internal/domain"
 	"slices"
 	"sync"
+	"time"
+
+	"github.com/google/uuid"
 )
 
 // Storage хранит корзины по ID.
@@ -14,13 +17,17 @@ type Storage = map[int64]*CartEntity
 // OrderRepositoryInMemory хранит корзины пользователей в in-memory хранилище.
 type CartRepositoryInMemory struct {
 	storage Storage
-	mx      sync.RWMutex
+	// abandonedEvents неотправленные события о брошенных корзинах (outbox).
+	abandonedEvents []*domain.AbandonedCartEvent
+	now             func() time.Time
+	mx              sync.RWMutex
 }
 
 // NewInMemoryCartRepository создает новый репозиторий корзины с in-memory хранилищем.
 func NewInMemoryCartRepository(cap int) *CartRepositoryInMemory {
 	return &CartRepositoryInMemory{
 		storage: make(Storage, cap),
+		now:     time.Now,
 	}
 }
 
@@ -31,13 +38,21 @@ func (r *CartRepositoryInMemory) getCartBy(userID int64) (*CartEntity, bool) {
 
 func (r *CartRepositoryInMemory) createCartBy(userID int64) *CartEntity {
 	cart := &CartEntity{
-		Items: make(map[int64]*domain.CartItem),
+		Items:      make(map[int64]*domain.CartItem),
+		SavedItems: make(map[int64]*domain.CartItem),
+		UpdatedAt:  r.now(),
 	}
 	r.storage[userID] = cart
 
 	return cart
 }
 
+// touch отмечает изменение корзины: обновляет время изменения и сбрасывает признак брошенной корзины.
+func (r *CartRepositoryInMemory) touch(cart *CartEntity) {
+	cart.UpdatedAt = r.now()
+	cart.AbandonedNotified = false
+}
+
 // UpsertCartItem добавляет товар или обновляет количество товара в корзине пользователя в in-memory хранилище.
 func (r *CartRepositoryInMemory) UpsertCartItem(_ context.Context, userID int64, newItem *domain.CartItem) (*domain.CartItem, error) {
 	r.mx.Lock()
@@ -51,10 +66,12 @@ func (r *CartRepositoryInMemory) UpsertCartItem(_ context.Context, userID int64,
 	item, ok := cart.Items[newItem.Sku]
 	if ok {
 		item.Count += newItem.Count
+		item.AddedPrice = newItem.AddedPrice
 	} else {
 		cart.Items[newItem.Sku] = newItem
 		item = newItem
 	}
+	r.touch(cart)
 
 	return item, nil
 }
@@ -70,20 +87,189 @@ func (r *CartRepositoryInMemory) DeleteCartItem(_ context.Context, userID, skuID
 	}
 
 	delete(cart.Items, skuID)
+	r.touch(cart)
+
+	return nil
+}
+
+// SetPromoCode сохраняет промокод корзины пользователя в in-memory хранилище.
+// Если корзины нет, промокод не сохраняется.
+func (r *CartRepositoryInMemory) SetPromoCode(_ context.Context, userID int64, code string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		return nil
+	}
+
+	cart.PromoCode = code
+	r.touch(cart)
 
 	return nil
 }
 
 // DeleteCart удаляет корзину пользователя из in-memory хранилища.
+// Отложенные товары при этом сохраняются.
 func (r *CartRepositoryInMemory) DeleteCart(_ context.Context, userID int64) error {
 	r.mx.Lock()
 	defer r.mx.Unlock()
 
-	delete(r.storage, userID)
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		return nil
+	}
+
+	if len(cart.SavedItems) == 0 {
+		delete(r.storage, userID)
+		return nil
+	}
+
+	cart.Items = make(map[int64]*domain.CartItem)
+	cart.PromoCode = ""
+	r.touch(cart)
+
+	return nil
+}
+
+// MergeCarts переносит товары из корзины fromCartID в корзину пользователя по правилу policy и удаляет
+// корзину fromCartID в in-memory хранилище. Обе корзины читаются и изменяются под одной блокировкой.
+// Если корзины fromCartID нет, например она уже объединена, корзина пользователя не изменяется.
+// Промокод корзины пользователя сохраняется.
+func (r *CartRepositoryInMemory) MergeCarts(_ context.Context, userID, fromCartID int64, policy domain.MergePolicy) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	fromCart, ok := r.getCartBy(fromCartID)
+	if !ok {
+		return nil
+	}
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		cart = r.createCartBy(userID)
+	}
+
+	for sku, fromItem := range fromCart.Items {
+		if item, ok := cart.Items[sku]; ok {
+			item.Count = policy.Merge(item.Count, fromItem.Count)
+			continue
+		}
+
+		itemCopy := *fromItem
+		cart.Items[sku] = &itemCopy
+	}
+	r.touch(cart)
+
+	delete(r.storage, fromCartID)
+
+	return nil
+}
+
+// GetSavedItemsOrderBySku возвращает отложенные товары пользователя, отсортированные по SKU, из in-memory хранилища.
+func (r *CartRepositoryInMemory) GetSavedItemsOrderBySku(_ context.Context, userID int64) ([]*domain.CartItem, error) {
+	r.mx.RLock()
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		r.mx.RUnlock()
+		return []*domain.CartItem{}, nil
+	}
+
+	items := make([]*domain.CartItem, 0, len(cart.SavedItems))
+	for _, item := range cart.SavedItems {
+		itemCopy := *item
+		items = append(items, &itemCopy)
+	}
+
+	r.mx.RUnlock()
+
+	slices.SortFunc(items, func(a, b *domain.CartItem) int {
+		return cmp.Compare(a.Sku, b.Sku)
+	})
+
+	return items, nil
+}
+
+// MoveCartItemToSaved переносит товар из корзины в список отложенных в in-memory хранилище.
+// Если товар уже отложен, количество суммируется.
+func (r *CartRepositoryInMemory) MoveCartItemToSaved(_ context.Context, userID, skuID int64) (*domain.CartItem, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		return nil, domain.ErrCartItemNotFound
+	}
+
+	item, ok := cart.Items[skuID]
+	if !ok {
+		return nil, domain.ErrCartItemNotFound
+	}
+	delete(cart.Items, skuID)
+
+	saved := moveItem(cart.SavedItems, item)
+	r.touch(cart)
+	savedCopy := *saved
+
+	return &savedCopy, nil
+}
+
+// MoveSavedItemToCart переносит товар из списка отложенных в корзину в in-memory хранилище.
+// Цена на момент добавления заменяется на addedPrice, если товар уже в корзине, количество суммируется.
+func (r *CartRepositoryInMemory) MoveSavedItemToCart(_ context.Context, userID, skuID int64, addedPrice domain.Money) (*domain.CartItem, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		return nil, domain.ErrSavedItemNotFound
+	}
+
+	item, ok := cart.SavedItems[skuID]
+	if !ok {
+		return nil, domain.ErrSavedItemNotFound
+	}
+	delete(cart.SavedItems, skuID)
+	item.AddedPrice = addedPrice
+
+	moved := moveItem(cart.Items, item)
+	r.touch(cart)
+	movedCopy := *moved
+
+	return &movedCopy, nil
+}
+
+// DeleteSavedItem удаляет товар из списка отложенных пользователя в in-memory хранилище.
+func (r *CartRepositoryInMemory) DeleteSavedItem(_ context.Context, userID, skuID int64) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	cart, ok := r.getCartBy(userID)
+	if !ok {
+		return nil
+	}
+
+	delete(cart.SavedItems, skuID)
+	r.touch(cart)
 
 	return nil
 }
 
+// moveItem кладет товар в items, суммируя количество с уже лежащим там товаром с тем же SKU.
+func moveItem(items map[int64]*domain.CartItem, item *domain.CartItem) *domain.CartItem {
+	existing, ok := items[item.Sku]
+	if !ok {
+		items[item.Sku] = item
+		return item
+	}
+
+	existing.Count += item.Count
+	existing.AddedPrice = item.AddedPrice
+
+	return existing
+}
+
 // GetCartByUserIDOrderBySku возвращает корзину пользователя с отсортированными по SKU товарами из in-memory хранилища.
 func (r *CartRepositoryInMemory) GetCartByUserIDOrderBySku(_ context.Context, userID int64) (*domain.Cart, error) {
 	r.mx.RLock()
@@ -95,10 +281,13 @@ func (r *CartRepositoryInMemory) GetCartByUserIDOrderBySku(_ context.Context, us
 	}
 
 	cartCopy := &domain.Cart{
-		Items: make([]*domain.CartItem, 0, len(cart.Items)),
+		Items:     make([]*domain.CartItem, 0, len(cart.Items)),
+		PromoCode: cart.PromoCode,
+		UpdatedAt: cart.UpdatedAt,
 	}
 	for _, item := range cart.Items {
-		cartCopy.Items = append(cartCopy.Items, item)
+		itemCopy := *item
+		cartCopy.Items = append(cartCopy.Items, &itemCopy)
 	}
 
 	r.mx.RUnlock()
@@ -110,6 +299,95 @@ func (r *CartRepositoryInMemory) GetCartByUserIDOrderBySku(_ context.Context, us
 	return cartCopy, nil
 }
 
+// DeleteCartsIdleSince удаляет из in-memory хранилища корзины, которые не изменялись с момента before.
+// Как и в DeleteCart, отложенные товары сохраняются: у таких корзин удаляются только товары и промокод.
+// Возвращает количество удаленных корзин.
+func (r *CartRepositoryInMemory) DeleteCartsIdleSince(_ context.Context, before time.Time) (int, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	deleted := 0
+	for cartID, cart := range r.storage {
+		if !cart.UpdatedAt.Before(before) {
+			continue
+		}
+
+		if len(cart.SavedItems) == 0 {
+			delete(r.storage, cartID)
+			deleted++
+			continue
+		}
+
+		if len(cart.Items) == 0 && cart.PromoCode == "" {
+			continue
+		}
+
+		cart.Items = make(map[int64]*domain.CartItem)
+		cart.PromoCode = ""
+		deleted++
+	}
+
+	return deleted, nil
+}
+
+// CollectAbandonedCarts создает события о брошенных корзинах пользователей, которые не изменялись с момента before,
+// и сохраняет их в outbox in-memory хранилища. Для каждой корзины событие создается один раз после последнего изменения.
+// Пустые и гостевые корзины пропускаются. Возвращает количество созданных событий.
+func (r *CartRepositoryInMemory) CollectAbandonedCarts(_ context.Context, before time.Time) (int, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	collected := 0
+	for cartID, cart := range r.storage {
+		if cartID <= 0 || cart.AbandonedNotified || len(cart.Items) == 0 || !cart.UpdatedAt.Before(before) {
+			continue
+		}
+
+		items := make([]*domain.CartItem, 0, len(cart.Items))
+		for _, item := range cart.Items {
+			itemCopy := *item
+			items = append(items, &itemCopy)
+		}
+		slices.SortFunc(items, func(a, b *domain.CartItem) int {
+			return cmp.Compare(a.Sku, b.Sku)
+		})
+
+		r.abandonedEvents = append(r.abandonedEvents, &domain.AbandonedCartEvent{
+			EventID:   uuid.NewString(),
+			UserID:    cartID,
+			Items:     items,
+			UpdatedAt: cart.UpdatedAt,
+		})
+		cart.AbandonedNotified = true
+		collected++
+	}
+
+	return collected, nil
+}
+
+// GetUnsentAbandonedCartEvents возвращает не более limit неотправленных событий о брошенных корзинах
+// в порядке создания из in-memory хранилища.
+func (r *CartRepositoryInMemory) GetUnsentAbandonedCartEvents(_ context.Context, limit int) ([]*domain.AbandonedCartEvent, error) {
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	events := r.abandonedEvents[:min(limit, len(r.abandonedEvents))]
+
+	return slices.Clone(events), nil
+}
+
+// DeleteAbandonedCartEvents удаляет отправленные события о брошенных корзинах из in-memory хранилища.
+func (r *CartRepositoryInMemory) DeleteAbandonedCartEvents(_ context.Context, eventIDs []string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.abandonedEvents = slices.DeleteFunc(r.abandonedEvents, func(event *domain.AbandonedCartEvent) bool {
+		return slices.Contains(eventIDs, event.EventID)
+	})
+
+	return nil
+}
+
 // Возращает кол-во созданные корзин в хранилище
 func (r *CartRepositoryInMemory) CountObjects() int {
 	r.mx.Lock()
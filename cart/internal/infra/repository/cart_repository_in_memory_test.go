@@ -6,9 +6,11 @@ import (
 	"math/big"
 	"sync"
 	"testing"
+	"time"
 
 	"route256/cart/internal/domain"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
@@ -18,6 +20,10 @@ func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
 
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
 func TestCartRepositoryInMemory(t *testing.T) {
 	t.Parallel()
 
@@ -30,7 +36,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			Sku:   1,
 			Name:  "Item 1",
 			Count: 1,
-			Price: 100,
+			Price: rub(100),
 		}
 		userID := int64(1)
 
@@ -53,7 +59,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			Sku:   1,
 			Name:  "Item 1",
 			Count: 1,
-			Price: 100,
+			Price: rub(100),
 		}
 		userID := int64(1)
 
@@ -79,7 +85,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			Sku:   1,
 			Name:  "Item 1",
 			Count: 1,
-			Price: 100,
+			Price: rub(100),
 		}
 		userID := int64(1)
 
@@ -104,7 +110,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			Sku:   1,
 			Name:  "Item 1",
 			Count: 1,
-			Price: 100,
+			Price: rub(100),
 		}
 		userID := int64(1)
 
@@ -129,7 +135,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			Sku:   1,
 			Name:  "Item 1",
 			Count: 1,
-			Price: 100,
+			Price: rub(100),
 		}
 		userID := int64(1)
 
@@ -201,7 +207,7 @@ func TestCartRepositoryInMemory(t *testing.T) {
 				Sku:   sku.Int64(),
 				Name:  "Item 1",
 				Count: 1,
-				Price: 100,
+				Price: rub(100),
 			}
 
 			_, err = repo.UpsertCartItem(ctx, userID, item)
@@ -216,6 +222,365 @@ func TestCartRepositoryInMemory(t *testing.T) {
 			assert.LessOrEqual(t, cart.Items[i-1].Sku, cart.Items[i].Sku)
 		}
 	})
+
+	t.Run("set and remove promo code", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID := int64(1)
+
+		_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.SetPromoCode(ctx, userID, "SALE10"))
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, "SALE10", cart.PromoCode)
+
+		require.NoError(t, repo.SetPromoCode(ctx, userID, ""))
+		cart, err = repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, cart.PromoCode)
+	})
+
+	t.Run("promo code is not saved without cart", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+
+		require.NoError(t, repo.SetPromoCode(ctx, 1, "SALE10"))
+		assert.Equal(t, 0, repo.CountObjects())
+	})
+
+	t.Run("move item between cart and saved list", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID := int64(1)
+
+		_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 2, AddedPrice: rub(100)})
+		require.NoError(t, err)
+		_, err = repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 2, Count: 1, AddedPrice: rub(300)})
+		require.NoError(t, err)
+
+		saved, err := repo.MoveCartItemToSaved(ctx, userID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, uint32(2), saved.Count)
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, cart.Items, 1)
+		assert.Equal(t, int64(2), cart.Items[0].Sku)
+
+		savedItems, err := repo.GetSavedItemsOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, savedItems, 1)
+		assert.Equal(t, int64(1), savedItems[0].Sku)
+
+		moved, err := repo.MoveSavedItemToCart(ctx, userID, 1, rub(150))
+		require.NoError(t, err)
+		assert.Equal(t, rub(150), moved.AddedPrice)
+
+		savedItems, err = repo.GetSavedItemsOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, savedItems)
+
+		cart, err = repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Len(t, cart.Items, 2)
+	})
+
+	t.Run("move missing items", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+
+		_, err := repo.MoveCartItemToSaved(ctx, 1, 1)
+		require.ErrorIs(t, err, domain.ErrCartItemNotFound)
+
+		_, err = repo.MoveSavedItemToCart(ctx, 1, 1, rub(100))
+		require.ErrorIs(t, err, domain.ErrSavedItemNotFound)
+	})
+
+	t.Run("merge carts", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID, guestCartID := int64(1), int64(-1)
+
+		_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+		require.NoError(t, repo.SetPromoCode(ctx, userID, "SALE10"))
+		_, err = repo.UpsertCartItem(ctx, guestCartID, &domain.CartItem{Sku: 2, Count: 2})
+		require.NoError(t, err)
+
+		_, err = repo.UpsertCartItem(ctx, guestCartID, &domain.CartItem{Sku: 1, Count: 2})
+		require.NoError(t, err)
+
+		err = repo.MergeCarts(ctx, userID, guestCartID, domain.MergeSum)
+		require.NoError(t, err)
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []*domain.CartItem{{Sku: 1, Count: 3}, {Sku: 2, Count: 2}}, cart.Items)
+		assert.Equal(t, "SALE10", cart.PromoCode)
+		assert.Equal(t, 1, repo.CountObjects())
+	})
+
+	t.Run("repeated merge does not change cart", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID, guestCartID := int64(1), int64(-1)
+
+		_, err := repo.UpsertCartItem(ctx, guestCartID, &domain.CartItem{Sku: 1, Count: 2})
+		require.NoError(t, err)
+
+		for range 2 {
+			err = repo.MergeCarts(ctx, userID, guestCartID, domain.MergeSum)
+			require.NoError(t, err)
+		}
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []*domain.CartItem{{Sku: 1, Count: 2}}, cart.Items)
+	})
+
+	t.Run("concurrent merges and additions are not lost", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID, guestCartID := int64(1), int64(-1)
+
+		_, err := repo.UpsertCartItem(ctx, guestCartID, &domain.CartItem{Sku: 1, Count: 5})
+		require.NoError(t, err)
+
+		const additions = 10
+		var wg sync.WaitGroup
+		for range additions {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 1})
+				assert.NoError(t, err)
+			}()
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, repo.MergeCarts(ctx, userID, guestCartID, domain.MergeSum))
+			}()
+		}
+		wg.Wait()
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Equal(t, []*domain.CartItem{{Sku: 1, Count: 5 + additions}}, cart.Items)
+	})
+
+	t.Run("saved items are kept when cart is cleared", func(t *testing.T) {
+		t.Parallel()
+
+		repo := NewInMemoryCartRepository(10)
+		ctx := context.Background()
+		userID := int64(1)
+
+		_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+		_, err = repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 2, Count: 1})
+		require.NoError(t, err)
+		_, err = repo.MoveCartItemToSaved(ctx, userID, 1)
+		require.NoError(t, err)
+
+		require.NoError(t, repo.DeleteCart(ctx, userID))
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, cart.Items)
+
+		savedItems, err := repo.GetSavedItemsOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Len(t, savedItems, 1)
+
+		require.NoError(t, repo.DeleteSavedItem(ctx, userID, 1))
+		savedItems, err = repo.GetSavedItemsOrderBySku(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, savedItems)
+	})
+}
+
+// fakeClock возвращает управляемое тестом текущее время.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestCartRepositoryInMemory_Expiry(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newRepo := func() (*CartRepositoryInMemory, *fakeClock) {
+		clock := &fakeClock{now: start}
+		repo := NewInMemoryCartRepository(10)
+		repo.now = clock.Now
+
+		return repo, clock
+	}
+
+	t.Run("cart changes update last modified time", func(t *testing.T) {
+		t.Parallel()
+
+		repo, clock := newRepo()
+		ctx := context.Background()
+
+		_, err := repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+
+		clock.now = start.Add(time.Hour)
+		require.NoError(t, repo.SetPromoCode(ctx, 1, "SALE10"))
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, start.Add(time.Hour), cart.UpdatedAt)
+	})
+
+	t.Run("delete idle carts", func(t *testing.T) {
+		t.Parallel()
+
+		repo, clock := newRepo()
+		ctx := context.Background()
+
+		_, err := repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+		clock.now = start.Add(2 * time.Hour)
+		_, err = repo.UpsertCartItem(ctx, 2, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+
+		deleted, err := repo.DeleteCartsIdleSince(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		assert.Equal(t, 1, repo.CountObjects())
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, cart.Items)
+	})
+
+	t.Run("delete idle carts keeps saved items", func(t *testing.T) {
+		t.Parallel()
+
+		repo, _ := newRepo()
+		ctx := context.Background()
+
+		_, err := repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+		_, err = repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 2, Count: 2})
+		require.NoError(t, err)
+		_, err = repo.MoveCartItemToSaved(ctx, 1, 2)
+		require.NoError(t, err)
+		require.NoError(t, repo.SetPromoCode(ctx, 1, "SALE10"))
+
+		deleted, err := repo.DeleteCartsIdleSince(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+		assert.Equal(t, 1, repo.CountObjects())
+
+		cart, err := repo.GetCartByUserIDOrderBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Empty(t, cart.Items)
+		assert.Empty(t, cart.PromoCode)
+
+		saved, err := repo.GetSavedItemsOrderBySku(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		assert.EqualValues(t, 2, saved[0].Sku)
+
+		deleted, err = repo.DeleteCartsIdleSince(ctx, start.Add(time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, deleted)
+	})
+
+	t.Run("abandoned cart event is collected once until cart changes", func(t *testing.T) {
+		t.Parallel()
+
+		repo, clock := newRepo()
+		ctx := context.Background()
+
+		_, err := repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 2, Count: 1})
+		require.NoError(t, err)
+		_, err = repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 1, Count: 3})
+		require.NoError(t, err)
+		// гостевые и пустые корзины не считаются брошенными
+		_, err = repo.UpsertCartItem(ctx, -1, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+		require.NoError(t, repo.DeleteCartItem(ctx, 3, 1))
+
+		clock.now = start.Add(2 * time.Hour)
+		idleBefore := start.Add(time.Hour)
+
+		collected, err := repo.CollectAbandonedCarts(ctx, idleBefore)
+		require.NoError(t, err)
+		assert.Equal(t, 1, collected)
+
+		collected, err = repo.CollectAbandonedCarts(ctx, idleBefore)
+		require.NoError(t, err)
+		assert.Equal(t, 0, collected)
+
+		events, err := repo.GetUnsentAbandonedCartEvents(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.NoError(t, uuid.Validate(events[0].EventID))
+		assert.Equal(t, &domain.AbandonedCartEvent{
+			EventID:   events[0].EventID,
+			UserID:    1,
+			Items:     []*domain.CartItem{{Sku: 1, Count: 3}, {Sku: 2, Count: 1}},
+			UpdatedAt: start,
+		}, events[0])
+
+		require.NoError(t, repo.DeleteAbandonedCartEvents(ctx, []string{events[0].EventID}))
+		events, err = repo.GetUnsentAbandonedCartEvents(ctx, 10)
+		require.NoError(t, err)
+		assert.Empty(t, events)
+
+		_, err = repo.UpsertCartItem(ctx, 1, &domain.CartItem{Sku: 1, Count: 1})
+		require.NoError(t, err)
+
+		clock.now = start.Add(4 * time.Hour)
+		collected, err = repo.CollectAbandonedCarts(ctx, start.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, collected)
+	})
+
+	t.Run("get unsent abandoned cart events respects limit", func(t *testing.T) {
+		t.Parallel()
+
+		repo, clock := newRepo()
+		ctx := context.Background()
+
+		for userID := int64(1); userID <= 3; userID++ {
+			_, err := repo.UpsertCartItem(ctx, userID, &domain.CartItem{Sku: 1, Count: 1})
+			require.NoError(t, err)
+		}
+
+		clock.now = start.Add(time.Hour)
+		collected, err := repo.CollectAbandonedCarts(ctx, clock.now)
+		require.NoError(t, err)
+		require.Equal(t, 3, collected)
+
+		events, err := repo.GetUnsentAbandonedCartEvents(ctx, 2)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.NotEqual(t, events[0].EventID, events[1].EventID)
+	})
 }
 
 func BenchmarkUpsertCartItemParallel(b *testing.B) {
@@ -238,7 +603,7 @@ func BenchmarkUpsertCartItemParallel(b *testing.B) {
 		cartItems[i] = &domain.CartItem{
 			Sku:   sku.Int64(),
 			Name:  "any",
-			Price: 10,
+			Price: rub(10),
 			Count: uint32(count.Uint64()), // #nosec G115: value is guaranteed to fit in uint32
 		}
 	}
@@ -276,7 +641,7 @@ func BenchmarkCartRepositoryParallel(b *testing.B) {
 		cartItems[i] = &domain.CartItem{
 			Sku:   sku.Int64(),
 			Name:  "any",
-			Price: 10,
+			Price: rub(10),
 			Count: uint32(count.Uint64()), // #nosec G115: value is guaranteed to fit in uint32
 		}
 	}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"route256/cart/internal/domain"
+	"strings"
+)
+
+// PromoRepositoryInMemory хранит промокоды в in-memory хранилище.
+// Коды промокодов не чувствительны к регистру.
+type PromoRepositoryInMemory struct {
+	storage map[string]*domain.Promotion
+}
+
+// NewInMemoryPromoRepository создает новый репозиторий промокодов с in-memory хранилищем.
+func NewInMemoryPromoRepository(promotions []*domain.Promotion) *PromoRepositoryInMemory {
+	storage := make(map[string]*domain.Promotion, len(promotions))
+	for _, promo := range promotions {
+		storage[strings.ToUpper(promo.Code)] = promo
+	}
+
+	return &PromoRepositoryInMemory{
+		storage: storage,
+	}
+}
+
+// GetPromotionByCode возвращает промокод по его коду из in-memory хранилища.
+func (r *PromoRepositoryInMemory) GetPromotionByCode(_ context.Context, code string) (*domain.Promotion, error) {
+	promo, ok := r.storage[strings.ToUpper(code)]
+	if !ok {
+		return nil, domain.ErrPromoNotFound
+	}
+
+	return promo, nil
+}
@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"route256/cart/internal/domain"
+	"sync"
+	"time"
+)
+
+const sessionTokenBytes = 16
+
+// guestSession сессия анонимного пользователя.
+type guestSession struct {
+	cartID     int64
+	lastUsedAt time.Time
+}
+
+// SessionRepositoryInMemory хранит сессии анонимных пользователей и выделенные им корзины в in-memory хранилище.
+// Гостевым корзинам выдаются отрицательные идентификаторы, чтобы они не пересекались с ID пользователей.
+type SessionRepositoryInMemory struct {
+	sessions   map[string]*guestSession
+	lastCartID int64
+	now        func() time.Time
+	mx         sync.Mutex
+}
+
+// NewInMemorySessionRepository создает новый репозиторий сессий с in-memory хранилищем.
+func NewInMemorySessionRepository() *SessionRepositoryInMemory {
+	return &SessionRepositoryInMemory{
+		sessions: make(map[string]*guestSession),
+		now:      time.Now,
+	}
+}
+
+// CreateSession создает сессию анонимного пользователя и возвращает ее токен.
+func (r *SessionRepositoryInMemory) CreateSession(_ context.Context) (string, error) {
+	b := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("rand.Read: %w", err)
+	}
+	token := hex.EncodeToString(b)
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.lastCartID--
+	r.sessions[token] = &guestSession{
+		cartID:     r.lastCartID,
+		lastUsedAt: r.now(),
+	}
+
+	return token, nil
+}
+
+// GetCartIDByToken возвращает идентификатор гостевой корзины по токену сессии
+// и продлевает сессию.
+func (r *SessionRepositoryInMemory) GetCartIDByToken(_ context.Context, token string) (int64, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	session, ok := r.sessions[token]
+	if !ok {
+		return 0, domain.ErrSessionNotFound
+	}
+	session.lastUsedAt = r.now()
+
+	return session.cartID, nil
+}
+
+// DeleteSession удаляет сессию анонимного пользователя.
+func (r *SessionRepositoryInMemory) DeleteSession(_ context.Context, token string) error {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	delete(r.sessions, token)
+
+	return nil
+}
+
+// DeleteSessionsIdleSince удаляет сессии, которые не использовались с момента before.
+// Возвращает количество удаленных сессий.
+func (r *SessionRepositoryInMemory) DeleteSessionsIdleSince(_ context.Context, before time.Time) (int, error) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	deleted := 0
+	for token, session := range r.sessions {
+		if session.lastUsedAt.Before(before) {
+			delete(r.sessions, token)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"route256/cart/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionRepositoryInMemory(t *testing.T) {
+	t.Parallel()
+
+	repo := NewInMemorySessionRepository()
+	ctx := context.Background()
+
+	token1, err := repo.CreateSession(ctx)
+	require.NoError(t, err)
+	token2, err := repo.CreateSession(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, token1, token2)
+
+	cartID1, err := repo.GetCartIDByToken(ctx, token1)
+	require.NoError(t, err)
+	cartID2, err := repo.GetCartIDByToken(ctx, token2)
+	require.NoError(t, err)
+	assert.Less(t, cartID1, int64(0))
+	assert.Less(t, cartID2, int64(0))
+	assert.NotEqual(t, cartID1, cartID2)
+
+	require.NoError(t, repo.DeleteSession(ctx, token1))
+	_, err = repo.GetCartIDByToken(ctx, token1)
+	require.ErrorIs(t, err, domain.ErrSessionNotFound)
+}
+
+func TestSessionRepositoryInMemory_DeleteSessionsIdleSince(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: start}
+	repo := NewInMemorySessionRepository()
+	repo.now = clock.Now
+	ctx := context.Background()
+
+	idleToken, err := repo.CreateSession(ctx)
+	require.NoError(t, err)
+	usedToken, err := repo.CreateSession(ctx)
+	require.NoError(t, err)
+
+	clock.now = start.Add(2 * time.Hour)
+	_, err = repo.GetCartIDByToken(ctx, usedToken)
+	require.NoError(t, err)
+
+	deleted, err := repo.DeleteSessionsIdleSince(ctx, start.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	_, err = repo.GetCartIDByToken(ctx, idleToken)
+	require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	_, err = repo.GetCartIDByToken(ctx, usedToken)
+	require.NoError(t, err)
+}
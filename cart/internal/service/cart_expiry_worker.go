@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/logger"
+)
+
+// CartExpiryRepository описывает методы хранилища корзин для вытеснения неактивных корзин
+// и outbox событий о брошенных корзинах.
+type CartExpiryRepository interface {
+	DeleteCartsIdleSince(ctx context.Context, before time.Time) (int, error)
+	CollectAbandonedCarts(ctx context.Context, before time.Time) (int, error)
+	GetUnsentAbandonedCartEvents(ctx context.Context, limit int) ([]*domain.AbandonedCartEvent, error)
+	DeleteAbandonedCartEvents(ctx context.Context, eventIDs []string) error
+}
+
+// SessionExpiryRepository описывает методы хранилища сессий анонимных пользователей для вытеснения
+// неактивных сессий.
+type SessionExpiryRepository interface {
+	DeleteSessionsIdleSince(ctx context.Context, before time.Time) (int, error)
+}
+
+// AbandonedCartPublisher публикует события о брошенных корзинах.
+type AbandonedCartPublisher interface {
+	Send(key string, value *domain.AbandonedCartEvent) error
+}
+
+// CartExpiryConfig настройки вытеснения корзин и публикации событий о брошенных корзинах.
+// Нулевой TTL отключает вытеснение, нулевой AbandonAfter - события о брошенных корзинах.
+type CartExpiryConfig struct {
+	// TTL время без изменений, после которого корзина удаляется из хранилища.
+	// Сессии анонимных пользователей удаляются после TTL без использования.
+	TTL time.Duration
+	// AbandonAfter время без изменений, после которого корзина считается брошенной.
+	AbandonAfter time.Duration
+	Period       time.Duration
+	BatchSize    int
+}
+
+// CartExpiryWorker периодически вытесняет неактивные корзины и сессии анонимных пользователей
+// и публикует события о брошенных корзинах.
+type CartExpiryWorker struct {
+	repo     CartExpiryRepository
+	sessions SessionExpiryRepository
+	pub      AbandonedCartPublisher
+	config   CartExpiryConfig
+	now      func() time.Time
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewCartExpiryWorker создает новый экземпляр CartExpiryWorker.
+// Если pub равен nil, события о брошенных корзинах не создаются.
+func NewCartExpiryWorker(
+	repo CartExpiryRepository,
+	sessions SessionExpiryRepository,
+	pub AbandonedCartPublisher,
+	config CartExpiryConfig,
+) *CartExpiryWorker {
+	if pub == nil {
+		config.AbandonAfter = 0
+	}
+
+	return &CartExpiryWorker{
+		repo:     repo,
+		sessions: sessions,
+		pub:      pub,
+		config:   config,
+		now:      time.Now,
+	}
+}
+
+// Start запускает периодическую обработку корзин до отмены ctx или вызова Stop.
+func (w *CartExpiryWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.config.Period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				err := w.process(ctx)
+				if err != nil {
+					logger.Warnw("error at CartExpiryWorker.process()", "err", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop останавливает обработку и дожидается завершения текущей итерации.
+func (w *CartExpiryWorker) Stop() {
+	w.cancel()
+	<-w.done
+}
+
+// process сначала сохраняет события о брошенных корзинах в outbox, затем вытесняет корзины и сессии по TTL,
+// чтобы корзина не была удалена раньше, чем по ней создано событие, и после этого отправляет события.
+func (w *CartExpiryWorker) process(ctx context.Context) error {
+	now := w.now()
+
+	if w.config.AbandonAfter > 0 {
+		collected, err := w.repo.CollectAbandonedCarts(ctx, now.Add(-w.config.AbandonAfter))
+		if err != nil {
+			return fmt.Errorf("repo.CollectAbandonedCarts: %w", err)
+		}
+		if collected > 0 {
+			logger.Infow("abandoned carts collected", "count", collected)
+		}
+	}
+
+	if w.config.TTL > 0 {
+		deleted, err := w.repo.DeleteCartsIdleSince(ctx, now.Add(-w.config.TTL))
+		if err != nil {
+			return fmt.Errorf("repo.DeleteCartsIdleSince: %w", err)
+		}
+		if deleted > 0 {
+			logger.Infow("expired carts deleted", "count", deleted)
+		}
+
+		deleted, err = w.sessions.DeleteSessionsIdleSince(ctx, now.Add(-w.config.TTL))
+		if err != nil {
+			return fmt.Errorf("sessions.DeleteSessionsIdleSince: %w", err)
+		}
+		if deleted > 0 {
+			logger.Infow("expired guest sessions deleted", "count", deleted)
+		}
+	}
+
+	if w.pub == nil {
+		return nil
+	}
+
+	return w.sendAbandonedCartEvents(ctx)
+}
+
+// sendAbandonedCartEvents отправляет пачку событий из outbox. Неотправленные события остаются в outbox
+// и будут отправлены повторно на следующей итерации.
+func (w *CartExpiryWorker) sendAbandonedCartEvents(ctx context.Context) error {
+	events, err := w.repo.GetUnsentAbandonedCartEvents(ctx, w.config.BatchSize)
+	if err != nil {
+		return fmt.Errorf("repo.GetUnsentAbandonedCartEvents: %w", err)
+	}
+
+	sentIDs := make([]string, 0, len(events))
+	for _, event := range events {
+		innerErr := w.pub.Send(strconv.FormatInt(event.UserID, 10), event)
+		if innerErr != nil {
+			logger.Warnw("failed to send abandoned cart event", "event_id", event.EventID, "err", innerErr)
+			continue
+		}
+
+		sentIDs = append(sentIDs, event.EventID)
+	}
+
+	if len(sentIDs) == 0 {
+		return nil
+	}
+
+	err = w.repo.DeleteAbandonedCartEvents(ctx, sentIDs)
+	if err != nil {
+		return fmt.Errorf("repo.DeleteAbandonedCartEvents: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"route256/cart/internal/domain"
+	mock "route256/cart/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testComponentCEW struct {
+	repoMock     *mock.CartExpiryRepositoryMock
+	sessionsMock *mock.SessionExpiryRepositoryMock
+	pubMock      *mock.AbandonedCartPublisherMock
+	worker       *CartExpiryWorker
+	now          time.Time
+}
+
+func newTestComponentCEW(t *testing.T, config CartExpiryConfig) *testComponentCEW {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartExpiryRepositoryMock(mc)
+	sessionsMock := mock.NewSessionExpiryRepositoryMock(mc)
+	pubMock := mock.NewAbandonedCartPublisherMock(mc)
+
+	now := time.Date(2024, 1, 2, 12, 0, 0, 0, time.UTC)
+	worker := NewCartExpiryWorker(repoMock, sessionsMock, pubMock, config)
+	worker.now = func() time.Time { return now }
+
+	return &testComponentCEW{
+		repoMock:     repoMock,
+		sessionsMock: sessionsMock,
+		pubMock:      pubMock,
+		worker:       worker,
+		now:          now,
+	}
+}
+
+func TestCartExpiryWorker(t *testing.T) {
+	t.Parallel()
+
+	config := CartExpiryConfig{
+		TTL:          72 * time.Hour,
+		AbandonAfter: 24 * time.Hour,
+		Period:       time.Minute,
+		BatchSize:    10,
+	}
+
+	t.Run("collect, evict and send abandoned cart events", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCEW(t, config)
+		ctx := context.Background()
+
+		event := &domain.AbandonedCartEvent{
+			EventID:   "1",
+			UserID:    42,
+			Items:     []*domain.CartItem{{Sku: 1, Count: 2}},
+			UpdatedAt: tc.now.Add(-25 * time.Hour),
+		}
+
+		tc.repoMock.CollectAbandonedCartsMock.Expect(minimock.AnyContext, tc.now.Add(-24*time.Hour)).Return(1, nil)
+		tc.repoMock.DeleteCartsIdleSinceMock.Expect(minimock.AnyContext, tc.now.Add(-72*time.Hour)).Return(0, nil)
+		tc.sessionsMock.DeleteSessionsIdleSinceMock.Expect(minimock.AnyContext, tc.now.Add(-72*time.Hour)).Return(0, nil)
+		tc.repoMock.GetUnsentAbandonedCartEventsMock.Expect(minimock.AnyContext, 10).
+			Return([]*domain.AbandonedCartEvent{event}, nil)
+		tc.pubMock.SendMock.Expect("42", event).Return(nil)
+		tc.repoMock.DeleteAbandonedCartEventsMock.Expect(minimock.AnyContext, []string{"1"}).Return(nil)
+
+		err := tc.worker.process(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("failed events stay in outbox", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCEW(t, config)
+		ctx := context.Background()
+
+		events := []*domain.AbandonedCartEvent{
+			{EventID: "1", UserID: 1},
+			{EventID: "2", UserID: 2},
+		}
+
+		tc.repoMock.CollectAbandonedCartsMock.Return(0, nil)
+		tc.repoMock.DeleteCartsIdleSinceMock.Return(0, nil)
+		tc.sessionsMock.DeleteSessionsIdleSinceMock.Return(0, nil)
+		tc.repoMock.GetUnsentAbandonedCartEventsMock.Return(events, nil)
+		tc.pubMock.SendMock.When("1", events[0]).Then(errors.New("kafka unavailable"))
+		tc.pubMock.SendMock.When("2", events[1]).Then(nil)
+		tc.repoMock.DeleteAbandonedCartEventsMock.Expect(minimock.AnyContext, []string{"2"}).Return(nil)
+
+		err := tc.worker.process(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("nothing is deleted when all sends failed", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCEW(t, config)
+
+		tc.repoMock.CollectAbandonedCartsMock.Return(0, nil)
+		tc.repoMock.DeleteCartsIdleSinceMock.Return(0, nil)
+		tc.sessionsMock.DeleteSessionsIdleSinceMock.Return(0, nil)
+		tc.repoMock.GetUnsentAbandonedCartEventsMock.Return([]*domain.AbandonedCartEvent{{EventID: "1", UserID: 1}}, nil)
+		tc.pubMock.SendMock.Return(errors.New("kafka unavailable"))
+
+		err := tc.worker.process(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("eviction only without publisher", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		repoMock := mock.NewCartExpiryRepositoryMock(mc)
+		sessionsMock := mock.NewSessionExpiryRepositoryMock(mc)
+		worker := NewCartExpiryWorker(repoMock, sessionsMock, nil, config)
+
+		repoMock.DeleteCartsIdleSinceMock.Return(3, nil)
+		sessionsMock.DeleteSessionsIdleSinceMock.Return(3, nil)
+
+		err := worker.process(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("collect error stops processing", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCEW(t, config)
+
+		tc.repoMock.CollectAbandonedCartsMock.Return(0, errors.New("error"))
+
+		err := tc.worker.process(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, uint64(0), tc.repoMock.DeleteCartsIdleSinceAfterCounter())
+	})
+
+	t.Run("sessions are kept when cart eviction failed", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCEW(t, config)
+
+		tc.repoMock.CollectAbandonedCartsMock.Return(0, nil)
+		tc.repoMock.DeleteCartsIdleSinceMock.Return(0, errors.New("error"))
+
+		err := tc.worker.process(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, uint64(0), tc.sessionsMock.DeleteSessionsIdleSinceAfterCounter())
+	})
+}
+
+func TestCartExpiryWorker_Stop(t *testing.T) {
+	t.Parallel()
+
+	mc := minimock.NewController(t)
+	repoMock := mock.NewCartExpiryRepositoryMock(mc)
+	sessionsMock := mock.NewSessionExpiryRepositoryMock(mc)
+	worker := NewCartExpiryWorker(repoMock, sessionsMock, nil, CartExpiryConfig{TTL: time.Hour, Period: time.Millisecond})
+
+	sessionsMock.DeleteSessionsIdleSinceMock.Return(0, nil)
+
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	repoMock.DeleteCartsIdleSinceMock.Set(func(_ context.Context, _ time.Time) (int, error) {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+
+		return 0, nil
+	})
+
+	worker.Start(context.Background())
+	<-started
+
+	stopped := make(chan struct{})
+	go func() {
+		worker.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned before the in-flight iteration finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	<-stopped
+
+	calls := repoMock.DeleteCartsIdleSinceAfterCounter()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, calls, repoMock.DeleteCartsIdleSinceAfterCounter())
+}
@@ -1,10 +1,13 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"math/big"
 	"route256/cart/internal/domain"
-	"route256/cart/pkg/myerrgroup"
+	"route256/cart/pkg/logger"
+	"slices"
 )
 
 // CartRepository описывает методы работы с корзинами в хранилище.
@@ -18,12 +21,44 @@ type CartRepository interface {
 	UpsertCartItem(ctx context.Context, userID int64, newItem *domain.CartItem) (*domain.CartItem, error)
 	//  DeleteCartItem удаляет товар из корзины пользователя по SKU.
 	DeleteCartItem(ctx context.Context, userID, skuID int64) error
+
+	// SetPromoCode сохраняет промокод корзины пользователя. Пустой код удаляет промокод.
+	SetPromoCode(ctx context.Context, userID int64, code string) error
+
+	// GetSavedItemsOrderBySku возвращает отложенные товары пользователя, отсортированные по SKU.
+	GetSavedItemsOrderBySku(ctx context.Context, userID int64) ([]*domain.CartItem, error)
+	// MoveCartItemToSaved переносит товар из корзины в список отложенных.
+	MoveCartItemToSaved(ctx context.Context, userID, skuID int64) (*domain.CartItem, error)
+	// MoveSavedItemToCart переносит товар из списка отложенных в корзину с ценой на момент добавления addedPrice.
+	MoveSavedItemToCart(ctx context.Context, userID, skuID int64, addedPrice domain.Money) (*domain.CartItem, error)
+	// DeleteSavedItem удаляет товар из списка отложенных.
+	DeleteSavedItem(ctx context.Context, userID, skuID int64) error
+
+	// MergeCarts переносит товары из корзины fromCartID в корзину пользователя по правилу policy
+	// и удаляет корзину fromCartID. Обе корзины читаются и изменяются атомарно.
+	MergeCarts(ctx context.Context, userID, fromCartID int64, policy domain.MergePolicy) error
+}
+
+// PromoRepository описывает методы получения промокодов.
+type PromoRepository interface {
+	// GetPromotionByCode возвращает промокод по его коду.
+	GetPromotionByCode(ctx context.Context, code string) (*domain.Promotion, error)
 }
 
 // ProductService описывает методы работы с товарами.
 type ProductService interface {
 	// GetProductBySku возвращает информацию о товаре по SKU.
 	GetProductBySku(ctx context.Context, sku int64) (*domain.Product, error)
+	// GetProductsBySkus возвращает информацию о товарах по списку SKU. Ненайденные товары отсутствуют в результате.
+	GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*domain.Product, error)
+}
+
+// LastKnownProductService описывает источник последних известных данных о товарах.
+// Его реализуют только реализации ProductService, которые хранят полученные данные.
+type LastKnownProductService interface {
+	// GetLastKnownProductsBySkus возвращает последние известные данные о товарах без обращения к сервису.
+	// Данные могут быть устаревшими, товары без известных данных отсутствуют в результате.
+	GetLastKnownProductsBySkus(ctx context.Context, skus []int64) map[int64]*domain.Product
 }
 
 // LomsService описывает методы работы с запасами.
@@ -32,43 +67,108 @@ type LomsService interface {
 	GetStockInfo(ctx context.Context, skuID int64) (uint32, error)
 }
 
+// RateProvider описывает источник курсов валют.
+type RateProvider interface {
+	// Rate возвращает курс пересчета: количество основных единиц валюты to за основную единицу валюты from.
+	Rate(ctx context.Context, from, to string) (*big.Rat, error)
+}
+
 // CartService содержит бизнес-логику для работы с корзиной.
 type CartService struct {
 	cartRepository CartRepository
 	productService ProductService
 	lomsService    LomsService
+	promoRepo      PromoRepository
+
+	baseCurrency string
+	rates        RateProvider
+	mergePolicy  domain.MergePolicy
+}
+
+// CartServiceOption настраивает CartService.
+type CartServiceOption func(*CartService)
+
+// WithCurrencyConversion включает пересчет сумм корзины в валюту baseCurrency по курсам rates.
+// Без этой опции корзина не принимает товары в разных валютах.
+func WithCurrencyConversion(baseCurrency string, rates RateProvider) CartServiceOption {
+	return func(s *CartService) {
+		s.baseCurrency = baseCurrency
+		s.rates = rates
+	}
+}
+
+// WithMergePolicy задает правило объединения корзин, если оно не указано в запросе. По умолчанию domain.MergeSum.
+func WithMergePolicy(policy domain.MergePolicy) CartServiceOption {
+	return func(s *CartService) {
+		s.mergePolicy = policy
+	}
 }
 
 // NewCartService конструктор для CartService.
-func NewCartService(repository CartRepository, productService ProductService, lomsService LomsService) *CartService {
-	return &CartService{
+func NewCartService(
+	repository CartRepository,
+	productService ProductService,
+	lomsService LomsService,
+	promoRepo PromoRepository,
+	opts ...CartServiceOption,
+) *CartService {
+	s := &CartService{
 		cartRepository: repository,
 		productService: productService,
 		lomsService:    lomsService,
+		promoRepo:      promoRepo,
+		baseCurrency:   domain.DefaultCurrency,
+		mergePolicy:    domain.MergeSum,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
 // AddCartItem добавляет товар в корзину пользователя, если хватает запасов.
+// Текущая цена товара запоминается как цена на момент добавления.
+// Если пересчет валют выключен, товар в валюте, отличной от валюты корзины, не добавляется.
 func (s *CartService) AddCartItem(ctx context.Context, userID int64, newItem *domain.CartItem) (*domain.CartItem, error) {
-	_, err := s.productService.GetProductBySku(ctx, newItem.Sku)
+	err := s.checkNewCartItem(ctx, userID, newItem)
 	if err != nil {
-		return nil, fmt.Errorf("productService.GetProductBySku: %w", err)
+		return nil, fmt.Errorf("s.checkNewCartItem: %w", err)
 	}
 
-	productStock, err := s.lomsService.GetStockInfo(ctx, newItem.Sku)
+	addedCartItem, err := s.cartRepository.UpsertCartItem(ctx, userID, newItem)
 	if err != nil {
-		return nil, fmt.Errorf("lomsService.GetStockInfo: %w", err)
+		return nil, fmt.Errorf("cartRepository.UpsertCartItem: %w", err)
 	}
-	if productStock < newItem.Count {
-		return nil, domain.ErrOutOfStock
+
+	return addedCartItem, nil
+}
+
+// checkNewCartItem проверяет, что товар можно положить в корзину: валюта совпадает с валютой корзины
+// и запасов хватает. Текущая цена товара запоминается в AddedPrice.
+func (s *CartService) checkNewCartItem(ctx context.Context, userID int64, newItem *domain.CartItem) error {
+	product, err := s.productService.GetProductBySku(ctx, newItem.Sku)
+	if err != nil {
+		return fmt.Errorf("productService.GetProductBySku: %w", err)
 	}
+	newItem.AddedPrice = product.Price
 
-	addedCartItem, err := s.cartRepository.UpsertCartItem(ctx, userID, newItem)
+	if s.rates == nil {
+		err = s.checkCartCurrency(ctx, userID, newItem)
+		if err != nil {
+			return fmt.Errorf("s.checkCartCurrency: %w", err)
+		}
+	}
+
+	productStock, err := s.lomsService.GetStockInfo(ctx, newItem.Sku)
 	if err != nil {
-		return nil, fmt.Errorf("cartRepository.UpsertCartItem: %w", err)
+		return fmt.Errorf("lomsService.GetStockInfo: %w", err)
+	}
+	if productStock < newItem.Count {
+		return domain.ErrOutOfStock
 	}
 
-	return addedCartItem, nil
+	return nil
 }
 
 // DeleteCartItem удаляет товар из корзины пользователя.
@@ -92,35 +192,432 @@ func (s *CartService) ClearCart(ctx context.Context, userID int64) error {
 }
 
 // GetCart возвращает содержимое корзины пользователя.
+// Если сервис product недоступен, корзина собирается из последних известных данных о товарах
+// и помечается как неполная. Товары, которых нет в сервисе product, помечаются недоступными,
+// но корзину неполной не делают: повторный запрос их не вернет.
 func (s *CartService) GetCart(ctx context.Context, userID int64) (*domain.Cart, error) {
 	cart, err := s.cartRepository.GetCartByUserIDOrderBySku(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("cartRepository.GetCartByUserIDOrderBySku: %w", err)
 	}
 
-	errGroup, ctx := myerrgroup.WithContext(ctx)
+	if len(cart.Items) == 0 {
+		return cart, nil
+	}
+
+	skus := make([]int64, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		skus = append(skus, item.Sku)
+	}
+
+	availability := domain.ItemAvailable
+	products, err := s.productService.GetProductsBySkus(ctx, skus)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("productService.GetProductsBySkus: %w", err)
+		}
 
+		logger.WarnwCtx(ctx, fmt.Sprintf("productService.GetProductsBySkus: %s, cart is built from last known products", err))
+		products = s.getLastKnownProducts(ctx, skus)
+		availability = domain.ItemStale
+	}
+
+	totals := make(map[string]domain.Money)
 	for _, item := range cart.Items {
-		errGroup.Go(func() error {
-			product, itemErr := s.productService.GetProductBySku(ctx, item.Sku)
-			if itemErr != nil {
-				return fmt.Errorf("productService.GetProductBySku: %w", itemErr)
+		product, ok := products[item.Sku]
+		if !ok {
+			item.Availability = domain.ItemUnavailable
+			if availability == domain.ItemStale {
+				cart.Partial = true
 			}
+			continue
+		}
+
+		item.Name = product.Name
+		item.Price = product.Price
+		item.Availability = availability
+		if availability != domain.ItemAvailable {
+			cart.Partial = true
+		}
 
-			item.Name = product.Name
-			item.Price = product.Price
-			return nil
-		})
+		if !item.AddedPrice.IsZero() && item.AddedPrice != item.Price {
+			item.PriceChanged = true
+			cart.PriceChanged = true
+		}
+
+		itemTotal, err := item.Price.Mul(item.Count)
+		if err != nil {
+			return nil, fmt.Errorf("item.Price.Mul: %w", err)
+		}
+
+		total, ok := totals[itemTotal.Currency]
+		if !ok {
+			total = domain.ZeroMoney(itemTotal.Currency)
+		}
+		totals[itemTotal.Currency], err = total.Add(itemTotal)
+		if err != nil {
+			return nil, fmt.Errorf("total.Add: %w", err)
+		}
 	}
 
-	err = errGroup.Wait()
+	cart.Totals = make([]domain.Money, 0, len(totals))
+	for _, total := range totals {
+		cart.Totals = append(cart.Totals, total)
+	}
+	slices.SortFunc(cart.Totals, func(a, b domain.Money) int {
+		return cmp.Compare(a.Currency, b.Currency)
+	})
+
+	if s.rates == nil && len(cart.Totals) > 1 {
+		cart.MixedCurrencies = true
+		return cart, nil
+	}
+
+	cart.Subtotal, err = s.subtotal(ctx, cart.Totals)
 	if err != nil {
-		return nil, fmt.Errorf("errGroup.Wait: %w", err)
+		return nil, fmt.Errorf("s.subtotal: %w", err)
 	}
 
-	for _, item := range cart.Items {
-		cart.TotalPrice += item.Count * item.Price
+	cart.TotalPrice = cart.Subtotal
+	if cart.PromoCode != "" {
+		err = s.applyDiscounts(ctx, cart)
+		if err != nil {
+			return nil, fmt.Errorf("s.applyDiscounts: %w", err)
+		}
+	}
+
+	return cart, nil
+}
+
+// GetSavedItems возвращает отложенные товары пользователя с актуальными названиями и ценами.
+// Если сервис product недоступен, используются последние известные данные о товарах.
+func (s *CartService) GetSavedItems(ctx context.Context, userID int64) ([]*domain.CartItem, error) {
+	items, err := s.cartRepository.GetSavedItemsOrderBySku(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.GetSavedItemsOrderBySku: %w", err)
+	}
+
+	if len(items) == 0 {
+		return items, nil
+	}
+
+	skus := make([]int64, 0, len(items))
+	for _, item := range items {
+		skus = append(skus, item.Sku)
+	}
+
+	availability := domain.ItemAvailable
+	products, err := s.productService.GetProductsBySkus(ctx, skus)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("productService.GetProductsBySkus: %w", err)
+		}
+
+		logger.WarnwCtx(ctx, fmt.Sprintf("productService.GetProductsBySkus: %s, saved items are built from last known products", err))
+		products = s.getLastKnownProducts(ctx, skus)
+		availability = domain.ItemStale
+	}
+
+	for _, item := range items {
+		product, ok := products[item.Sku]
+		if !ok {
+			item.Availability = domain.ItemUnavailable
+			continue
+		}
+
+		item.Name = product.Name
+		item.Price = product.Price
+		item.Availability = availability
+		item.PriceChanged = !item.AddedPrice.IsZero() && item.AddedPrice != item.Price
+	}
+
+	return items, nil
+}
+
+// SaveCartItemForLater переносит товар из корзины пользователя в список отложенных.
+func (s *CartService) SaveCartItemForLater(ctx context.Context, userID, skuID int64) (*domain.CartItem, error) {
+	item, err := s.cartRepository.MoveCartItemToSaved(ctx, userID, skuID)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.MoveCartItemToSaved: %w", err)
+	}
+
+	return item, nil
+}
+
+// MoveSavedItemToCart возвращает отложенный товар в корзину пользователя.
+// Как и при добавлении товара, проверяются запасы и валюта, цена на момент добавления обновляется.
+func (s *CartService) MoveSavedItemToCart(ctx context.Context, userID, skuID int64) (*domain.CartItem, error) {
+	savedItems, err := s.cartRepository.GetSavedItemsOrderBySku(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.GetSavedItemsOrderBySku: %w", err)
+	}
+
+	idx := slices.IndexFunc(savedItems, func(item *domain.CartItem) bool {
+		return item.Sku == skuID
+	})
+	if idx < 0 {
+		return nil, domain.ErrSavedItemNotFound
+	}
+
+	item := &domain.CartItem{
+		Sku:   skuID,
+		Count: savedItems[idx].Count,
+	}
+	err = s.checkNewCartItem(ctx, userID, item)
+	if err != nil {
+		return nil, fmt.Errorf("s.checkNewCartItem: %w", err)
+	}
+
+	movedItem, err := s.cartRepository.MoveSavedItemToCart(ctx, userID, skuID, item.AddedPrice)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.MoveSavedItemToCart: %w", err)
+	}
+
+	return movedItem, nil
+}
+
+// DeleteSavedItem удаляет товар из списка отложенных пользователя.
+func (s *CartService) DeleteSavedItem(ctx context.Context, userID, skuID int64) error {
+	err := s.cartRepository.DeleteSavedItem(ctx, userID, skuID)
+	if err != nil {
+		return fmt.Errorf("cartRepository.DeleteSavedItem: %w", err)
+	}
+
+	return nil
+}
+
+// MergeCarts переносит товары из гостевой корзины guestCartID в корзину пользователя.
+// Количество товаров, которые есть в обеих корзинах, определяется правилом policy, пустое правило заменяется
+// правилом по умолчанию. Если для итогового количества не хватает запасов, корзины не изменяются.
+// Итоговое количество вычисляется репозиторием по текущему состоянию корзин, поэтому одновременные
+// изменения корзины пользователя не теряются, а повторное объединение той же гостевой корзины ничего не меняет.
+func (s *CartService) MergeCarts(ctx context.Context, userID, guestCartID int64, policy domain.MergePolicy) (*domain.Cart, error) {
+	if policy == "" {
+		policy = s.mergePolicy
+	}
+
+	guestCart, err := s.cartRepository.GetCartByUserIDOrderBySku(ctx, guestCartID)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.GetCartByUserIDOrderBySku: %w", err)
+	}
+
+	userCart, err := s.cartRepository.GetCartByUserIDOrderBySku(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.GetCartByUserIDOrderBySku: %w", err)
+	}
+
+	userItems := make(map[int64]*domain.CartItem, len(userCart.Items))
+	for _, item := range userCart.Items {
+		userItems[item.Sku] = item
+	}
+
+	for _, guestItem := range guestCart.Items {
+		merged := *guestItem
+		if userItem, ok := userItems[guestItem.Sku]; ok {
+			merged = *userItem
+			merged.Count = policy.Merge(userItem.Count, guestItem.Count)
+			if merged.Count == userItem.Count {
+				continue
+			}
+		}
+
+		if s.rates == nil {
+			err = checkItemCurrency(userCart.Items, &merged)
+			if err != nil {
+				return nil, fmt.Errorf("checkItemCurrency: %w", err)
+			}
+		}
+
+		productStock, err := s.lomsService.GetStockInfo(ctx, merged.Sku)
+		if err != nil {
+			return nil, fmt.Errorf("lomsService.GetStockInfo: %w", err)
+		}
+		if productStock < merged.Count {
+			return nil, fmt.Errorf("%w: sku %d", domain.ErrOutOfStock, merged.Sku)
+		}
+	}
+
+	err = s.cartRepository.MergeCarts(ctx, userID, guestCartID, policy)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.MergeCarts: %w", err)
+	}
+
+	cart, err := s.GetCart(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetCart: %w", err)
 	}
 
 	return cart, nil
 }
+
+// ApplyPromoCode применяет промокод к корзине пользователя.
+// Промокод сохраняется, только если корзина удовлетворяет его условиям.
+func (s *CartService) ApplyPromoCode(ctx context.Context, userID int64, code string) (*domain.Cart, error) {
+	promo, err := s.promoRepo.GetPromotionByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("promoRepo.GetPromotionByCode: %w", err)
+	}
+
+	cart, err := s.GetCart(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("s.GetCart: %w", err)
+	}
+	if len(cart.Items) == 0 {
+		return nil, domain.ErrCartNotFound
+	}
+	if cart.MixedCurrencies {
+		return nil, domain.ErrCurrencyMismatch
+	}
+
+	discounts, ok, err := applyPromotion(promo, cart, s.converter(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("applyPromotion: %w", err)
+	}
+	if !ok {
+		return nil, domain.ErrPromoNotApplicable
+	}
+
+	err = setDiscounts(cart, discounts)
+	if err != nil {
+		return nil, fmt.Errorf("setDiscounts: %w", err)
+	}
+
+	err = s.cartRepository.SetPromoCode(ctx, userID, promo.Code)
+	if err != nil {
+		return nil, fmt.Errorf("cartRepository.SetPromoCode: %w", err)
+	}
+	cart.PromoCode = promo.Code
+
+	return cart, nil
+}
+
+// RemovePromoCode удаляет промокод из корзины пользователя.
+func (s *CartService) RemovePromoCode(ctx context.Context, userID int64) error {
+	err := s.cartRepository.SetPromoCode(ctx, userID, "")
+	if err != nil {
+		return fmt.Errorf("cartRepository.SetPromoCode: %w", err)
+	}
+
+	return nil
+}
+
+// applyDiscounts пересчитывает скидки по сохраненному промокоду корзины.
+// Если промокод больше не существует или не подходит к корзине, скидки не применяются, но промокод остается в корзине.
+func (s *CartService) applyDiscounts(ctx context.Context, cart *domain.Cart) error {
+	promo, err := s.promoRepo.GetPromotionByCode(ctx, cart.PromoCode)
+	if err != nil {
+		logger.WarnwCtx(ctx, fmt.Sprintf("promoRepo.GetPromotionByCode: %s", err))
+		return nil
+	}
+
+	discounts, ok, err := applyPromotion(promo, cart, s.converter(ctx))
+	if err != nil {
+		return fmt.Errorf("applyPromotion: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	return setDiscounts(cart, discounts)
+}
+
+// checkCartCurrency проверяет, что валюта нового товара совпадает с валютой остальных товаров корзины.
+func (s *CartService) checkCartCurrency(ctx context.Context, userID int64, newItem *domain.CartItem) error {
+	cart, err := s.cartRepository.GetCartByUserIDOrderBySku(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("cartRepository.GetCartByUserIDOrderBySku: %w", err)
+	}
+
+	return checkItemCurrency(cart.Items, newItem)
+}
+
+// checkItemCurrency проверяет, что валюта товара newItem совпадает с валютой остальных товаров items.
+func checkItemCurrency(items []*domain.CartItem, newItem *domain.CartItem) error {
+	currency := newItem.AddedPrice.Currency
+	if currency == "" {
+		return nil
+	}
+
+	for _, item := range items {
+		if item.Sku == newItem.Sku || item.AddedPrice.Currency == "" {
+			continue
+		}
+
+		if item.AddedPrice.Currency != currency {
+			return fmt.Errorf("%w: в корзине товары в %s, добавляемый товар в %s",
+				domain.ErrCurrencyMismatch, item.AddedPrice.Currency, currency)
+		}
+	}
+
+	return nil
+}
+
+// subtotal считает сумму корзины по суммам в отдельных валютах.
+// Если пересчет валют выключен, все суммы должны быть в одной валюте.
+func (s *CartService) subtotal(ctx context.Context, totals []domain.Money) (domain.Money, error) {
+	if s.rates == nil && len(totals) == 1 {
+		return totals[0], nil
+	}
+
+	convert := s.converter(ctx)
+	subtotal := domain.ZeroMoney(s.baseCurrency)
+	for _, total := range totals {
+		converted, err := convert(total)
+		if err != nil {
+			return domain.Money{}, fmt.Errorf("convert: %w", err)
+		}
+
+		subtotal, err = subtotal.Add(converted)
+		if err != nil {
+			return domain.Money{}, fmt.Errorf("subtotal.Add: %w", err)
+		}
+	}
+
+	return subtotal, nil
+}
+
+// moneyConverter переводит сумму в валюту корзины.
+type moneyConverter func(m domain.Money) (domain.Money, error)
+
+// converter возвращает функцию пересчета сумм в базовую валюту.
+// Если пересчет валют выключен, суммы возвращаются без изменений.
+func (s *CartService) converter(ctx context.Context) moneyConverter {
+	return func(m domain.Money) (domain.Money, error) {
+		if s.rates == nil || m.Currency == s.baseCurrency {
+			return m, nil
+		}
+
+		rate, err := s.rates.Rate(ctx, m.Currency, s.baseCurrency)
+		if err != nil {
+			return domain.Money{}, fmt.Errorf("rates.Rate: %w", err)
+		}
+
+		return m.Convert(rate, s.baseCurrency)
+	}
+}
+
+func setDiscounts(cart *domain.Cart, discounts []domain.Discount) error {
+	totalPrice := cart.Subtotal
+	for _, discount := range discounts {
+		var err error
+		totalPrice, err = totalPrice.Sub(discount.Amount)
+		if err != nil {
+			return fmt.Errorf("totalPrice.Sub: %w", err)
+		}
+	}
+
+	cart.Discounts = discounts
+	cart.TotalPrice = totalPrice
+
+	return nil
+}
+
+// getLastKnownProducts возвращает последние известные данные о товарах, если productService их хранит.
+func (s *CartService) getLastKnownProducts(ctx context.Context, skus []int64) map[int64]*domain.Product {
+	lastKnown, ok := s.productService.(LastKnownProductService)
+	if !ok {
+		return nil
+	}
+
+	return lastKnown.GetLastKnownProductsBySkus(ctx, skus)
+}
@@ -2,25 +2,42 @@ package service
 
 import (
 	"context"
+	"errors"
+	"math"
+	"math/big"
 	"testing"
 
 	"route256/cart/internal/domain"
 	mock "route256/cart/mocks"
+	"route256/cart/pkg/logger"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
+	"go.uber.org/zap"
 )
 
 func TestMain(m *testing.M) {
+	logger.InitLogger(&logger.Config{Level: zap.FatalLevel})
 	goleak.VerifyTestMain(m)
 }
 
+func rub(amount int64) domain.Money {
+	return domain.NewMoney(amount, domain.DefaultCurrency)
+}
+
+// cachedProductServiceMock ProductService, который хранит последние известные данные о товарах.
+type cachedProductServiceMock struct {
+	*mock.ProductServiceMock
+	*mock.LastKnownProductServiceMock
+}
+
 type testComponentCS struct {
 	cartRepoMock    *mock.CartRepositoryMock
 	productServMock *mock.ProductServiceMock
 	lomsServMock    *mock.LomsServiceMock
+	promoRepoMock   *mock.PromoRepositoryMock
 	cartService     *CartService
 }
 
@@ -29,13 +46,15 @@ func newTestComponentCS(t *testing.T) *testComponentCS {
 	cartRepoMock := mock.NewCartRepositoryMock(mc)
 	prouctServMock := mock.NewProductServiceMock(mc)
 	lomsServMock := mock.NewLomsServiceMock(mc)
-	cartService := NewCartService(cartRepoMock, prouctServMock, lomsServMock)
+	promoRepoMock := mock.NewPromoRepositoryMock(mc)
+	cartService := NewCartService(cartRepoMock, prouctServMock, lomsServMock, promoRepoMock)
 
 	return &testComponentCS{
 		cartRepoMock:    cartRepoMock,
 		productServMock: prouctServMock,
 		cartService:     cartService,
 		lomsServMock:    lomsServMock,
+		promoRepoMock:   promoRepoMock,
 	}
 }
 
@@ -48,11 +67,12 @@ func TestCartService(t *testing.T) {
 		tc := newTestComponentCS(t)
 
 		ctx := context.Background()
-		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: 100}
-		returnedProduct := &domain.Product{Sku: 1, Name: "name 1", Price: 100}
+		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: rub(100)}
+		returnedProduct := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
 		userID := int64(1)
 
 		tc.productServMock.GetProductBySkuMock.When(ctx, item.Sku).Then(returnedProduct, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(&domain.Cart{}, nil)
 		tc.lomsServMock.GetStockInfoMock.When(ctx, item.Sku).Then(100, nil)
 		tc.cartRepoMock.UpsertCartItemMock.When(ctx, userID, item).Then(item, nil)
 
@@ -68,7 +88,7 @@ func TestCartService(t *testing.T) {
 		tc := newTestComponentCS(t)
 
 		ctx := context.Background()
-		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: 100}
+		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: rub(100)}
 		userID := int64(1)
 
 		tc.productServMock.GetProductBySkuMock.When(ctx, item.Sku).Then(nil, domain.ErrProductNotFound)
@@ -88,24 +108,310 @@ func TestCartService(t *testing.T) {
 		item1 := &domain.CartItem{Sku: 1, Count: 2}
 		item2 := &domain.CartItem{Sku: 2, Count: 2}
 		item3 := &domain.CartItem{Sku: 3, Count: 2}
-		product1 := &domain.Product{Name: "name 1", Price: 100}
-		product2 := &domain.Product{Name: "name 2", Price: 300}
-		product3 := &domain.Product{Name: "name 3", Price: 200}
+		product1 := &domain.Product{Name: "name 1", Price: rub(100)}
+		product2 := &domain.Product{Name: "name 2", Price: rub(300)}
+		product3 := &domain.Product{Name: "name 3", Price: rub(200)}
 		userID := int64(1)
 
 		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
 			When(ctx, userID).
 			Then(&domain.Cart{Items: []*domain.CartItem{item1, item2, item3}}, nil)
 
-		tc.productServMock.GetProductBySkuMock.When(minimock.AnyContext, item1.Sku).Then(product1, nil)
-		tc.productServMock.GetProductBySkuMock.When(minimock.AnyContext, item2.Sku).Then(product2, nil)
-		tc.productServMock.GetProductBySkuMock.When(minimock.AnyContext, item3.Sku).Then(product3, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			When(ctx, []int64{item1.Sku, item2.Sku, item3.Sku}).
+			Then(map[int64]*domain.Product{item1.Sku: product1, item2.Sku: product2, item3.Sku: product3}, nil)
 
 		cart, err := tc.cartService.GetCart(ctx, userID)
 		require.NoError(t, err)
 
 		assert.Len(t, cart.Items, 3)
-		assert.EqualValues(t, 2*100+2*300+2*200, cart.TotalPrice)
+		assert.EqualValues(t, 2*100+2*300+2*200, cart.TotalPrice.Amount)
+		assert.Equal(t, []domain.Money{rub(2*100 + 2*300 + 2*200)}, cart.Totals)
+		assert.False(t, cart.MixedCurrencies)
+	})
+
+	t.Run("get cart with unexisting SKU at product service", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		item1 := &domain.CartItem{Sku: 1, Count: 2}
+		item2 := &domain.CartItem{Sku: 2, Count: 2}
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{item1, item2}}, nil)
+
+		tc.productServMock.GetProductsBySkusMock.
+			When(ctx, []int64{item1.Sku, item2.Sku}).
+			Then(map[int64]*domain.Product{item1.Sku: {Name: "name 1", Price: rub(100)}}, nil)
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.False(t, cart.Partial)
+		assert.Equal(t, domain.ItemAvailable, cart.Items[0].Availability)
+		assert.Equal(t, domain.ItemUnavailable, cart.Items[1].Availability)
+		assert.EqualValues(t, 2*100, cart.TotalPrice.Amount)
+	})
+
+	t.Run("get cart from last known products when product service unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+		lastKnownMock := mock.NewLastKnownProductServiceMock(t)
+		cartService := NewCartService(tc.cartRepoMock, &cachedProductServiceMock{
+			ProductServiceMock:          tc.productServMock,
+			LastKnownProductServiceMock: lastKnownMock,
+		}, tc.lomsServMock, tc.promoRepoMock)
+
+		ctx := context.Background()
+		item1 := &domain.CartItem{Sku: 1, Count: 2}
+		item2 := &domain.CartItem{Sku: 2, Count: 3}
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{item1, item2}}, nil)
+
+		tc.productServMock.GetProductsBySkusMock.
+			When(ctx, []int64{item1.Sku, item2.Sku}).
+			Then(nil, errors.New("product service unavailable"))
+		lastKnownMock.GetLastKnownProductsBySkusMock.
+			When(ctx, []int64{item1.Sku, item2.Sku}).
+			Then(map[int64]*domain.Product{item2.Sku: {Name: "name 2", Price: rub(300)}})
+
+		cart, err := cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.True(t, cart.Partial)
+		assert.Equal(t, domain.ItemUnavailable, cart.Items[0].Availability)
+		assert.Equal(t, domain.ItemStale, cart.Items[1].Availability)
+		assert.Equal(t, "name 2", cart.Items[1].Name)
+		assert.EqualValues(t, 3*300, cart.TotalPrice.Amount)
+	})
+
+	t.Run("get cart without last known products when product service unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		item := &domain.CartItem{Sku: 1, Count: 2}
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{item}}, nil)
+
+		tc.productServMock.GetProductsBySkusMock.
+			When(ctx, []int64{item.Sku}).
+			Then(nil, errors.New("product service unavailable"))
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.True(t, cart.Partial)
+		assert.Equal(t, domain.ItemUnavailable, cart.Items[0].Availability)
+	})
+
+	t.Run("get cart with canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 1}}}, nil)
+		tc.productServMock.GetProductsBySkusMock.Return(nil, context.Canceled)
+
+		_, err := tc.cartService.GetCart(ctx, userID)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("get cart with changed price", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		item1 := &domain.CartItem{Sku: 1, Count: 2, AddedPrice: rub(100)}
+		item2 := &domain.CartItem{Sku: 2, Count: 1, AddedPrice: rub(300)}
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{item1, item2}}, nil)
+
+		tc.productServMock.GetProductsBySkusMock.
+			When(ctx, []int64{item1.Sku, item2.Sku}).
+			Then(map[int64]*domain.Product{
+				item1.Sku: {Name: "name 1", Price: rub(100)},
+				item2.Sku: {Name: "name 2", Price: rub(350)},
+			}, nil)
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.True(t, cart.PriceChanged)
+		assert.False(t, cart.Items[0].PriceChanged)
+		assert.True(t, cart.Items[1].PriceChanged)
+		assert.EqualValues(t, 2*100+350, cart.TotalPrice.Amount)
+	})
+
+	t.Run("get cart with total price overflow", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 3}}}, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			Return(map[int64]*domain.Product{1: {Name: "name 1", Price: rub(math.MaxInt64 / 2)}}, nil)
+
+		_, err := tc.cartService.GetCart(context.Background(), 1)
+		require.ErrorIs(t, err, domain.ErrMoneyOverflow)
+	})
+
+	t.Run("get cart with promo code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		promo := &domain.Promotion{Code: "SALE", Rules: []domain.PromoRule{
+			{Kind: domain.PromoFixedAmount, Amount: 100},
+			{Kind: domain.PromoPercentage, Percent: 10},
+		}}
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 2}}, PromoCode: "SALE"}, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			Return(map[int64]*domain.Product{1: {Name: "name 1", Price: rub(550)}}, nil)
+		tc.promoRepoMock.GetPromotionByCodeMock.When(ctx, "SALE").Then(promo, nil)
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 1100, cart.Subtotal.Amount)
+		require.Len(t, cart.Discounts, 2)
+		assert.EqualValues(t, 100, cart.Discounts[0].Amount.Amount)
+		assert.EqualValues(t, 100, cart.Discounts[1].Amount.Amount)
+		assert.EqualValues(t, 900, cart.TotalPrice.Amount)
+	})
+
+	t.Run("get cart with promo code that is no longer applicable", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		promo := &domain.Promotion{Code: "BIG", Rules: []domain.PromoRule{
+			{Kind: domain.PromoMinTotal, MinTotal: 5000},
+			{Kind: domain.PromoFixedAmount, Amount: 500},
+		}}
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 1}}, PromoCode: "BIG"}, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			Return(map[int64]*domain.Product{1: {Name: "name 1", Price: rub(100)}}, nil)
+		tc.promoRepoMock.GetPromotionByCodeMock.Return(promo, nil)
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.Equal(t, "BIG", cart.PromoCode)
+		assert.Empty(t, cart.Discounts)
+		assert.EqualValues(t, 100, cart.TotalPrice.Amount)
+	})
+
+	t.Run("apply promo code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		promo := &domain.Promotion{Code: "SALE10", Rules: []domain.PromoRule{
+			{Kind: domain.PromoPercentage, Percent: 10},
+		}}
+
+		tc.promoRepoMock.GetPromotionByCodeMock.When(ctx, "sale10").Then(promo, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 2}}}, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			Return(map[int64]*domain.Product{1: {Name: "name 1", Price: rub(500)}}, nil)
+		tc.cartRepoMock.SetPromoCodeMock.When(ctx, userID, "SALE10").Then(nil)
+
+		cart, err := tc.cartService.ApplyPromoCode(ctx, userID, "sale10")
+		require.NoError(t, err)
+
+		assert.Equal(t, "SALE10", cart.PromoCode)
+		require.Len(t, cart.Discounts, 1)
+		assert.EqualValues(t, 900, cart.TotalPrice.Amount)
+	})
+
+	t.Run("apply unknown promo code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		tc.promoRepoMock.GetPromotionByCodeMock.Return(nil, domain.ErrPromoNotFound)
+
+		_, err := tc.cartService.ApplyPromoCode(context.Background(), 1, "UNKNOWN")
+		require.ErrorIs(t, err, domain.ErrPromoNotFound)
+	})
+
+	t.Run("apply promo code to cart below min total", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		promo := &domain.Promotion{Code: "BIG", Rules: []domain.PromoRule{
+			{Kind: domain.PromoMinTotal, MinTotal: 5000},
+			{Kind: domain.PromoFixedAmount, Amount: 500},
+		}}
+
+		tc.promoRepoMock.GetPromotionByCodeMock.Return(promo, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.
+			Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 1}}}, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			Return(map[int64]*domain.Product{1: {Name: "name 1", Price: rub(100)}}, nil)
+
+		_, err := tc.cartService.ApplyPromoCode(context.Background(), 1, "BIG")
+		require.ErrorIs(t, err, domain.ErrPromoNotApplicable)
+	})
+
+	t.Run("apply promo code to empty cart", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		tc.promoRepoMock.GetPromotionByCodeMock.Return(&domain.Promotion{Code: "SALE10"}, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.Return(&domain.Cart{Items: []*domain.CartItem{}}, nil)
+
+		_, err := tc.cartService.ApplyPromoCode(context.Background(), 1, "SALE10")
+		require.ErrorIs(t, err, domain.ErrCartNotFound)
+	})
+
+	t.Run("remove promo code", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		tc.cartRepoMock.SetPromoCodeMock.When(ctx, int64(1), "").Then(nil)
+
+		err := tc.cartService.RemovePromoCode(ctx, 1)
+		require.NoError(t, err)
 	})
 
 	t.Run("delete item from cart", func(t *testing.T) {
@@ -114,7 +420,7 @@ func TestCartService(t *testing.T) {
 		tc := newTestComponentCS(t)
 
 		ctx := context.Background()
-		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: 100}
+		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: rub(100)}
 		userID := int64(1)
 
 		tc.cartRepoMock.DeleteCartItemMock.
@@ -131,14 +437,293 @@ func TestCartService(t *testing.T) {
 		tc := newTestComponentCS(t)
 
 		ctx := context.Background()
-		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: 100}
-		product := &domain.Product{Sku: 1, Name: "name 1", Price: 100}
+		item := &domain.CartItem{Sku: 1, Count: 2, Name: "name 1", Price: rub(100)}
+		product := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
 		userID := int64(1)
 
 		tc.productServMock.GetProductBySkuMock.When(ctx, item.Sku).Then(product, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(&domain.Cart{}, nil)
 		tc.lomsServMock.GetStockInfoMock.When(ctx, item.Sku).Then(1, nil)
 
 		_, err := tc.cartService.AddCartItem(ctx, userID, item)
 		require.Error(t, err)
 	})
 }
+
+func TestCartService_Currency(t *testing.T) {
+	t.Parallel()
+
+	usd := func(amount int64) domain.Money {
+		return domain.NewMoney(amount, "USD")
+	}
+
+	t.Run("add item in other currency is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		item := &domain.CartItem{Sku: 2, Count: 1}
+
+		tc.productServMock.GetProductBySkuMock.When(ctx, item.Sku).
+			Then(&domain.Product{Sku: 2, Name: "name 2", Price: usd(10)}, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 1, AddedPrice: rub(100)}}}, nil)
+
+		_, err := tc.cartService.AddCartItem(ctx, userID, item)
+		require.ErrorIs(t, err, domain.ErrCurrencyMismatch)
+	})
+
+	t.Run("get cart with mixed currencies without conversion", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).
+			Then(&domain.Cart{
+				Items:     []*domain.CartItem{{Sku: 1, Count: 2}, {Sku: 2, Count: 1}},
+				PromoCode: "SALE10",
+			}, nil)
+		tc.productServMock.GetProductsBySkusMock.When(ctx, []int64{1, 2}).
+			Then(map[int64]*domain.Product{
+				1: {Sku: 1, Name: "name 1", Price: usd(10)},
+				2: {Sku: 2, Name: "name 2", Price: rub(100)},
+			}, nil)
+
+		cart, err := tc.cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.True(t, cart.MixedCurrencies)
+		assert.Equal(t, []domain.Money{rub(100), usd(20)}, cart.Totals)
+		assert.True(t, cart.TotalPrice.IsZero())
+		assert.Empty(t, cart.Discounts)
+	})
+
+	t.Run("get cart with currency conversion", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		cartRepoMock := mock.NewCartRepositoryMock(mc)
+		productServMock := mock.NewProductServiceMock(mc)
+		rateProviderMock := mock.NewRateProviderMock(mc)
+		cartService := NewCartService(cartRepoMock, productServMock, mock.NewLomsServiceMock(mc),
+			mock.NewPromoRepositoryMock(mc), WithCurrencyConversion(domain.DefaultCurrency, rateProviderMock))
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).
+			Then(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 2}, {Sku: 2, Count: 1}}}, nil)
+		productServMock.GetProductsBySkusMock.When(ctx, []int64{1, 2}).
+			Then(map[int64]*domain.Product{
+				1: {Sku: 1, Name: "name 1", Price: usd(10)},
+				2: {Sku: 2, Name: "name 2", Price: rub(100)},
+			}, nil)
+		rateProviderMock.RateMock.When(ctx, "USD", domain.DefaultCurrency).Then(big.NewRat(90, 1), nil)
+
+		cart, err := cartService.GetCart(ctx, userID)
+		require.NoError(t, err)
+
+		assert.False(t, cart.MixedCurrencies)
+		assert.Equal(t, []domain.Money{rub(100), usd(20)}, cart.Totals)
+		assert.Equal(t, rub(100+20*90), cart.Subtotal)
+		assert.Equal(t, rub(100+20*90), cart.TotalPrice)
+	})
+
+	t.Run("get cart with unknown currency rate", func(t *testing.T) {
+		t.Parallel()
+
+		mc := minimock.NewController(t)
+		cartRepoMock := mock.NewCartRepositoryMock(mc)
+		productServMock := mock.NewProductServiceMock(mc)
+		rateProviderMock := mock.NewRateProviderMock(mc)
+		cartService := NewCartService(cartRepoMock, productServMock, mock.NewLomsServiceMock(mc),
+			mock.NewPromoRepositoryMock(mc), WithCurrencyConversion(domain.DefaultCurrency, rateProviderMock))
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		cartRepoMock.GetCartByUserIDOrderBySkuMock.Return(&domain.Cart{Items: []*domain.CartItem{{Sku: 1, Count: 1}}}, nil)
+		productServMock.GetProductsBySkusMock.Return(map[int64]*domain.Product{1: {Sku: 1, Price: usd(10)}}, nil)
+		rateProviderMock.RateMock.Return(nil, domain.ErrCurrencyRateNotFound)
+
+		_, err := cartService.GetCart(ctx, userID)
+		require.ErrorIs(t, err, domain.ErrCurrencyRateNotFound)
+	})
+}
+
+func TestCartService_SavedItems(t *testing.T) {
+	t.Parallel()
+
+	t.Run("save cart item for later", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		saved := &domain.CartItem{Sku: 1, Count: 2, AddedPrice: rub(100)}
+
+		tc.cartRepoMock.MoveCartItemToSavedMock.When(ctx, userID, int64(1)).Then(saved, nil)
+
+		item, err := tc.cartService.SaveCartItemForLater(ctx, userID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, saved, item)
+	})
+
+	t.Run("get saved items", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		tc.cartRepoMock.GetSavedItemsOrderBySkuMock.When(ctx, userID).
+			Then([]*domain.CartItem{{Sku: 1, Count: 2, AddedPrice: rub(100)}, {Sku: 2, Count: 1}}, nil)
+		tc.productServMock.GetProductsBySkusMock.When(ctx, []int64{1, 2}).
+			Then(map[int64]*domain.Product{1: {Sku: 1, Name: "name 1", Price: rub(120)}}, nil)
+
+		items, err := tc.cartService.GetSavedItems(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, "name 1", items[0].Name)
+		assert.True(t, items[0].PriceChanged)
+		assert.Equal(t, domain.ItemUnavailable, items[1].Availability)
+	})
+
+	t.Run("move saved item to cart", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+		moved := &domain.CartItem{Sku: 1, Count: 2, AddedPrice: rub(120)}
+
+		tc.cartRepoMock.GetSavedItemsOrderBySkuMock.When(ctx, userID).
+			Then([]*domain.CartItem{{Sku: 1, Count: 2, AddedPrice: rub(100)}}, nil)
+		tc.productServMock.GetProductBySkuMock.When(ctx, int64(1)).
+			Then(&domain.Product{Sku: 1, Name: "name 1", Price: rub(120)}, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(&domain.Cart{}, nil)
+		tc.lomsServMock.GetStockInfoMock.When(ctx, int64(1)).Then(10, nil)
+		tc.cartRepoMock.MoveSavedItemToCartMock.When(ctx, userID, int64(1), rub(120)).Then(moved, nil)
+
+		item, err := tc.cartService.MoveSavedItemToCart(ctx, userID, 1)
+		require.NoError(t, err)
+		assert.Equal(t, moved, item)
+	})
+
+	t.Run("move saved item to cart with out of stock", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+		userID := int64(1)
+
+		tc.cartRepoMock.GetSavedItemsOrderBySkuMock.When(ctx, userID).
+			Then([]*domain.CartItem{{Sku: 1, Count: 5}}, nil)
+		tc.productServMock.GetProductBySkuMock.When(ctx, int64(1)).
+			Then(&domain.Product{Sku: 1, Name: "name 1", Price: rub(120)}, nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(&domain.Cart{}, nil)
+		tc.lomsServMock.GetStockInfoMock.When(ctx, int64(1)).Then(4, nil)
+
+		_, err := tc.cartService.MoveSavedItemToCart(ctx, userID, 1)
+		require.ErrorIs(t, err, domain.ErrOutOfStock)
+	})
+
+	t.Run("move unknown saved item to cart", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+
+		ctx := context.Background()
+
+		tc.cartRepoMock.GetSavedItemsOrderBySkuMock.Return([]*domain.CartItem{}, nil)
+
+		_, err := tc.cartService.MoveSavedItemToCart(ctx, 1, 1)
+		require.ErrorIs(t, err, domain.ErrSavedItemNotFound)
+	})
+}
+
+func TestCartService_MergeCarts(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userID      = int64(1)
+		guestCartID = int64(-1)
+	)
+
+	userCart := func() *domain.Cart {
+		return &domain.Cart{Items: []*domain.CartItem{
+			{Sku: 1, Count: 2, AddedPrice: rub(100)},
+		}}
+	}
+	guestCart := func() *domain.Cart {
+		return &domain.Cart{Items: []*domain.CartItem{
+			{Sku: 1, Count: 3, AddedPrice: rub(100)},
+			{Sku: 2, Count: 1, AddedPrice: rub(300)},
+		}}
+	}
+
+	tests := []struct {
+		name       string
+		policy     domain.MergePolicy
+		wantPolicy domain.MergePolicy
+	}{
+		{
+			name:       "default policy sums counts",
+			policy:     "",
+			wantPolicy: domain.MergeSum,
+		},
+		{
+			name:       "max",
+			policy:     domain.MergeMax,
+			wantPolicy: domain.MergeMax,
+		},
+		{
+			name:       "keep user",
+			policy:     domain.MergeKeepUser,
+			wantPolicy: domain.MergeKeepUser,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tc := newTestComponentCS(t)
+			ctx := context.Background()
+
+			tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, guestCartID).Then(guestCart(), nil)
+			tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(userCart(), nil)
+			tc.lomsServMock.GetStockInfoMock.Return(10, nil)
+			tc.cartRepoMock.MergeCartsMock.When(ctx, userID, guestCartID, tt.wantPolicy).Then(nil)
+			tc.productServMock.GetProductsBySkusMock.Return(map[int64]*domain.Product{
+				1: {Sku: 1, Name: "name 1", Price: rub(100)},
+			}, nil)
+
+			_, err := tc.cartService.MergeCarts(ctx, userID, guestCartID, tt.policy)
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("merged count is out of stock", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCS(t)
+		ctx := context.Background()
+
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, guestCartID).Then(guestCart(), nil)
+		tc.cartRepoMock.GetCartByUserIDOrderBySkuMock.When(ctx, userID).Then(userCart(), nil)
+		tc.lomsServMock.GetStockInfoMock.When(ctx, int64(1)).Then(4, nil)
+
+		_, err := tc.cartService.MergeCarts(ctx, userID, guestCartID, domain.MergeSum)
+		require.ErrorIs(t, err, domain.ErrOutOfStock)
+	})
+}
@@ -2,9 +2,13 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"route256/cart/internal/domain"
 	"route256/loms/pkg/api/orders/v1"
 	"route256/loms/pkg/api/stocks/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // LomsServiceGRPC реализует доступ к сервису loms по gRPC.
@@ -14,6 +18,7 @@ type LomsServiceGRPC struct {
 }
 
 // NewLomsServiceGRPC создает новый сервис управления заказами и запасами.
+// Повторные попытки, дедлайны и circuit breaker настраиваются интерцепторами gRPC-клиента.
 func NewLomsServiceGRPC(stockClient stocks.StockServiceV1Client, orderClient orders.OrderServiceV1Client) *LomsServiceGRPC {
 	return &LomsServiceGRPC{
 		stockClient: stockClient,
@@ -27,29 +32,69 @@ func (ls *LomsServiceGRPC) GetStockInfo(ctx context.Context, skuID int64) (uint3
 		SkuId: skuID,
 	})
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("stockClient.StockInfoV1: %w", lomsError(err))
 	}
 
 	return resp.Count, nil
 }
 
-// OrderCreate создает заказ по корзине.
+// OrderCreate создает заказ по корзине. Цены товаров в их валютах и примененная к корзине скидка передаются в заказ.
 func (ls *LomsServiceGRPC) OrderCreate(ctx context.Context, userID int64, cart *domain.Cart) (int64, error) {
 	req := &orders.OrderCreateRequest{
 		UserId: userID,
 		Items:  make([]*orders.ItemInfo, 0, len(cart.Items)),
 	}
 	for _, item := range cart.Items {
-		req.Items = append(req.Items, &orders.ItemInfo{
+		itemInfo := &orders.ItemInfo{
 			SkuId: item.Sku,
 			Count: item.Count,
-		})
+		}
+		if item.Price.Currency != "" {
+			itemInfo.Price = &orders.Money{
+				Units:    item.Price.Amount,
+				Currency: item.Price.Currency,
+			}
+		}
+		req.Items = append(req.Items, itemInfo)
+	}
+	if len(cart.Discounts) > 0 {
+		discount, err := cart.Subtotal.Sub(cart.TotalPrice)
+		if err != nil {
+			return 0, fmt.Errorf("cart.Subtotal.Sub: %w", err)
+		}
+
+		req.Discount = &orders.OrderDiscount{
+			PromoCode: cart.PromoCode,
+			Amount: &orders.Money{
+				Units:    discount.Amount,
+				Currency: discount.Currency,
+			},
+		}
 	}
 
 	resp, err := ls.orderClient.OrderCreateV1(ctx, req)
 	if err != nil {
-		return 0, err
+		return 0, fmt.Errorf("orderClient.OrderCreateV1: %w", lomsError(err))
 	}
 
 	return resp.OrderId, nil
 }
+
+// lomsError переводит gRPC-статус ответа loms в доменную ошибку.
+func lomsError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		return fmt.Errorf("%w: %s", domain.ErrStockNotFound, st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", domain.ErrNotEnoughStock, st.Message())
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return fmt.Errorf("%w: %s", domain.ErrLomsUnavailable, st.Message())
+	default:
+		return err
+	}
+}
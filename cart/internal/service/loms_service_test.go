@@ -13,6 +13,9 @@ import (
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type testComponentLS struct {
@@ -88,6 +91,42 @@ func TestLomsService(t *testing.T) {
 		assert.EqualValues(t, 1, orderID)
 	})
 
+	t.Run("order create with discount", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentLS(t)
+
+		cart := &domain.Cart{
+			Items: []*domain.CartItem{
+				&domain.CartItem{Sku: 1, Count: 10, Price: rub(100)},
+			},
+			Subtotal:   rub(1000),
+			TotalPrice: rub(900),
+			PromoCode:  "SALE10",
+			Discounts: []domain.Discount{
+				{PromoCode: "SALE10", Kind: domain.PromoPercentage, Amount: rub(100)},
+			},
+		}
+
+		tc.orderClientMock.OrderCreateV1Mock.Set(func(_ context.Context, req *orders.OrderCreateRequest, _ ...grpc.CallOption) (*orders.OrderCreateResponse, error) {
+			require.NotNil(t, req.Discount)
+			assert.Equal(t, "SALE10", req.Discount.PromoCode)
+			assert.EqualValues(t, 100, req.Discount.Amount.Units)
+			assert.Equal(t, domain.DefaultCurrency, req.Discount.Amount.Currency)
+			require.Len(t, req.Items, 1)
+			require.NotNil(t, req.Items[0].Price)
+			assert.EqualValues(t, 100, req.Items[0].Price.Units)
+			assert.Equal(t, domain.DefaultCurrency, req.Items[0].Price.Currency)
+
+			return &orders.OrderCreateResponse{OrderId: 1}, nil
+		})
+
+		orderID, err := tc.lomsService.OrderCreate(context.Background(), 1, cart)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 1, orderID)
+	})
+
 	t.Run("order create failed: failed order client", func(t *testing.T) {
 		t.Parallel()
 
@@ -105,4 +144,41 @@ func TestLomsService(t *testing.T) {
 		_, err := tc.lomsService.OrderCreate(ctx, userID, cart)
 		require.Error(t, err)
 	})
+
+	t.Run("get stock info maps grpc codes to domain errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			code    codes.Code
+			wantErr error
+		}{
+			{code: codes.NotFound, wantErr: domain.ErrStockNotFound},
+			{code: codes.DeadlineExceeded, wantErr: domain.ErrLomsUnavailable},
+		}
+
+		for _, tt := range tests {
+			tc := newTestComponentLS(t)
+
+			tc.stockClientMock.StockInfoV1Mock.
+				Return(nil, status.Error(tt.code, "error"))
+
+			_, err := tc.lomsService.GetStockInfo(context.Background(), 1)
+			require.ErrorIs(t, err, tt.wantErr, tt.code.String())
+		}
+	})
+
+	t.Run("order create maps failed precondition to domain error", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentLS(t)
+		cart := &domain.Cart{Items: []*domain.CartItem{
+			&domain.CartItem{Sku: 1, Count: 10},
+		}}
+
+		tc.orderClientMock.OrderCreateV1Mock.
+			Return(nil, status.Error(codes.FailedPrecondition, "not enough stock"))
+
+		_, err := tc.lomsService.OrderCreate(context.Background(), 1, cart)
+		require.ErrorIs(t, err, domain.ErrNotEnoughStock)
+	})
 }
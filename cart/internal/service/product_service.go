@@ -1,18 +1,25 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"math"
 	"net/http"
 	"route256/cart/internal/domain"
+	"route256/cart/pkg/myerrgroup"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 var ErrNotOk = errors.New("status not ok")
 
+var errBatchUnsupported = errors.New("batch endpoint not supported")
+
 // HTTPClient описывает операции выполнения HTTP запросов.
 type HTTPClient interface {
 	// Do выполняет HTTP запрос.
@@ -21,8 +28,18 @@ type HTTPClient interface {
 
 // RateLimiter описывает интерфейс для ограничения частоты запросов.
 type RateLimiter interface {
-	// Acquire блокирует до получения разрешения на выполнение запроса.
-	Acquire()
+	// Acquire блокирует до получения разрешения на выполнение запроса или отмены контекста.
+	Acquire(ctx context.Context) error
+}
+
+// ProductBatchConfig настройки получения нескольких товаров.
+type ProductBatchConfig struct {
+	// Path путь batch-эндпоинта сервиса product. Пустой путь отключает пакетные запросы.
+	Path string
+	// MaxBatchSize максимальное количество SKU в одном пакетном запросе.
+	MaxBatchSize int
+	// MaxFanOut максимальное количество одновременных запросов, если пакетные запросы недоступны.
+	MaxFanOut int
 }
 
 // ProductServiceHTTP реализует доступ к сервису product по HTTP.
@@ -31,15 +48,22 @@ type ProductServiceHTTP struct {
 	rateLimiter RateLimiter
 	token       string
 	address     string
+	batch       ProductBatchConfig
+
+	batchUnsupported atomic.Bool
 }
 
 // NewProductServiceHTTP конструктор для ProductServiceHTTP.
-func NewProductServiceHTTP(httpClient HTTPClient, rateLimiter RateLimiter, token string, address string) *ProductServiceHTTP {
+func NewProductServiceHTTP(httpClient HTTPClient, rateLimiter RateLimiter, token string, address string, batch ProductBatchConfig) *ProductServiceHTTP {
+	batch.MaxBatchSize = max(batch.MaxBatchSize, 1)
+	batch.MaxFanOut = max(batch.MaxFanOut, 1)
+
 	return &ProductServiceHTTP{
 		httpClient:  httpClient,
 		rateLimiter: rateLimiter,
 		token:       token,
 		address:     address,
+		batch:       batch,
 	}
 }
 
@@ -47,11 +71,23 @@ type GetProductResponse struct {
 	Name  string `json:"name"`
 	Price int32  `json:"price"`
 	Sku   int64  `json:"sku"`
+	// Currency код валюты цены. Если сервис product не передает валюту, используется DefaultCurrency.
+	Currency string `json:"currency,omitempty"`
+}
+
+type GetProductsRequest struct {
+	Skus []int64 `json:"skus"`
+}
+
+type GetProductsResponse struct {
+	Products []*GetProductResponse `json:"products"`
 }
 
 // GetProductBySku возвращает информацию о товаре по SKU из внешнего сервиса.
 func (s *ProductServiceHTTP) GetProductBySku(ctx context.Context, sku int64) (*domain.Product, error) {
-	s.rateLimiter.Acquire()
+	if err := s.rateLimiter.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("rateLimiter.Acquire: %w", err)
+	}
 
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
 	defer cancel()
@@ -88,14 +124,138 @@ func (s *ProductServiceHTTP) GetProductBySku(ctx context.Context, sku int64) (*d
 		return nil, fmt.Errorf("json.NewDecoder: %w", err)
 	}
 
-	price := resp.Price
-	if price < 0 || int64(price) > math.MaxUint32 {
-		return nil, fmt.Errorf("price out of range uint32: %d", price)
+	return toDomainProduct(resp)
+}
+
+// GetProductsBySkus возвращает информацию о товарах по списку SKU.
+// Товары запрашиваются пакетами через batch-эндпоинт, каждый пакет расходует одно разрешение rate limiter.
+// Если batch-эндпоинт недоступен, товары запрашиваются по одному с ограниченным параллелизмом.
+// Ненайденные товары отсутствуют в результате.
+func (s *ProductServiceHTTP) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*domain.Product, error) {
+	if s.batch.Path == "" || s.batchUnsupported.Load() {
+		return s.getProductsFanOut(ctx, skus)
+	}
+
+	products := make(map[int64]*domain.Product, len(skus))
+	for chunk := range slices.Chunk(skus, s.batch.MaxBatchSize) {
+		err := s.getProductsBatch(ctx, chunk, products)
+		if errors.Is(err, errBatchUnsupported) {
+			s.batchUnsupported.Store(true)
+			return s.getProductsFanOut(ctx, skus)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("s.getProductsBatch: %w", err)
+		}
+	}
+
+	return products, nil
+}
+
+func (s *ProductServiceHTTP) getProductsBatch(ctx context.Context, skus []int64, products map[int64]*domain.Product) error {
+	if err := s.rateLimiter.Acquire(ctx); err != nil {
+		return fmt.Errorf("rateLimiter.Acquire: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	body, err := json.Marshal(&GetProductsRequest{Skus: skus})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		s.address+s.batch.Path,
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	req.Header.Add("X-API-KEY", s.token)
+	req.Header.Add("Content-Type", "application/json")
+	req.Pattern = "POST " + s.batch.Path
+
+	response, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("httpClient.Do: %w", err)
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return errBatchUnsupported
+	default:
+		return ErrNotOk
+	}
+
+	resp := &GetProductsResponse{}
+	if err := json.NewDecoder(response.Body).Decode(resp); err != nil {
+		return fmt.Errorf("json.NewDecoder: %w", err)
+	}
+
+	for _, productResp := range resp.Products {
+		product, err := toDomainProduct(productResp)
+		if err != nil {
+			return err
+		}
+
+		products[product.Sku] = product
+	}
+
+	return nil
+}
+
+func (s *ProductServiceHTTP) getProductsFanOut(ctx context.Context, skus []int64) (map[int64]*domain.Product, error) {
+	products := make(map[int64]*domain.Product, len(skus))
+	var mx sync.Mutex
+
+	errGroup, ctx := myerrgroup.WithContext(ctx, myerrgroup.WithCollectAll())
+	errGroup.SetLimit(s.batch.MaxFanOut)
+
+	for _, sku := range skus {
+		errGroup.Go(func() error {
+			product, err := s.GetProductBySku(ctx, sku)
+			if errors.Is(err, domain.ErrProductNotFound) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("s.GetProductBySku(%d): %w", sku, err)
+			}
+
+			mx.Lock()
+			products[sku] = product
+			mx.Unlock()
+
+			return nil
+		})
+	}
+
+	if err := errGroup.Wait(); err != nil {
+		return nil, fmt.Errorf("errGroup.Wait: %w", err)
+	}
+
+	return products, nil
+}
+
+// toDomainProduct переводит ответ сервиса product в товар.
+// Сервис возвращает цену в минимальных единицах валюты domain.DefaultCurrency.
+func toDomainProduct(resp *GetProductResponse) (*domain.Product, error) {
+	if resp.Price < 0 {
+		return nil, fmt.Errorf("negative price: %d", resp.Price)
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(resp.Currency))
+	if currency == "" {
+		currency = domain.DefaultCurrency
 	}
 
 	return &domain.Product{
 		Name:  resp.Name,
-		Price: uint32(price),
+		Price: domain.NewMoney(int64(resp.Price), currency),
 		Sku:   resp.Sku,
 	}, nil
 }
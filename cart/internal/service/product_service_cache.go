@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"route256/cart/internal/domain"
+	"route256/cart/pkg/lrucache"
+	"route256/cart/pkg/metrics"
+	"sync"
+	"time"
+)
+
+const productCacheName = "product"
+
+// ProductCacheConfig настройки кэша товаров.
+type ProductCacheConfig struct {
+	// Capacity максимальное количество товаров в кэше.
+	Capacity int
+	// TTL время хранения найденного товара.
+	TTL time.Duration
+	// NegativeTTL время хранения информации об отсутствии товара.
+	NegativeTTL time.Duration
+	// LoadTimeout ограничение времени общей загрузки промахов, 0 - без ограничения.
+	LoadTimeout time.Duration
+}
+
+// productCacheEntry запись кэша. product == nil означает, что товар не найден.
+type productCacheEntry struct {
+	product *domain.Product
+}
+
+// productCall загрузка товара, результат которой ожидают все одновременные запросы этого SKU.
+// product == nil без ошибки означает, что товар не найден.
+type productCall struct {
+	done    chan struct{}
+	product *domain.Product
+	err     error
+}
+
+// productLoader загружает товары из ProductService. Ненайденные товары отсутствуют в результате.
+type productLoader func(ctx context.Context, skus []int64) (map[int64]*domain.Product, error)
+
+// CachedProductService кэширует ответы ProductService.
+// Одновременные запросы одного и того же SKU при промахе, в том числе из разных пачек,
+// выполняются одним запросом к сервису.
+type CachedProductService struct {
+	productService ProductService
+	cfg            ProductCacheConfig
+	cache          *lrucache.Cache[int64, productCacheEntry]
+
+	mu       sync.Mutex
+	inflight map[int64]*productCall
+}
+
+// NewCachedProductService конструктор для CachedProductService.
+func NewCachedProductService(productService ProductService, cfg ProductCacheConfig) *CachedProductService {
+	return &CachedProductService{
+		productService: productService,
+		cfg:            cfg,
+		cache:          lrucache.New[int64, productCacheEntry](cfg.Capacity),
+		inflight:       make(map[int64]*productCall),
+	}
+}
+
+// GetProductBySku возвращает информацию о товаре по SKU из кэша или из ProductService.
+func (s *CachedProductService) GetProductBySku(ctx context.Context, sku int64) (*domain.Product, error) {
+	if entry, ok := s.cache.Get(sku); ok {
+		if entry.product == nil {
+			metrics.IncCacheRequestCount(productCacheName, "negative_hit")
+			return nil, domain.ErrProductNotFound
+		}
+
+		metrics.IncCacheRequestCount(productCacheName, "hit")
+		return copyProduct(entry.product), nil
+	}
+
+	metrics.IncCacheRequestCount(productCacheName, "miss")
+
+	products, err := s.loadMisses(ctx, []int64{sku}, s.loadProduct)
+	if err != nil {
+		return nil, err
+	}
+
+	product, ok := products[sku]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+
+	return product, nil
+}
+
+// GetProductsBySkus возвращает информацию о товарах по списку SKU.
+// Из ProductService запрашиваются только товары, которых нет в кэше.
+func (s *CachedProductService) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*domain.Product, error) {
+	products := make(map[int64]*domain.Product, len(skus))
+	misses := make([]int64, 0, len(skus))
+
+	for _, sku := range skus {
+		entry, ok := s.cache.Get(sku)
+		switch {
+		case !ok:
+			metrics.IncCacheRequestCount(productCacheName, "miss")
+			misses = append(misses, sku)
+		case entry.product == nil:
+			metrics.IncCacheRequestCount(productCacheName, "negative_hit")
+		default:
+			metrics.IncCacheRequestCount(productCacheName, "hit")
+			products[sku] = copyProduct(entry.product)
+		}
+	}
+
+	if len(misses) == 0 {
+		return products, nil
+	}
+
+	loaded, err := s.loadMisses(ctx, misses, s.loadProducts)
+	if err != nil {
+		return nil, err
+	}
+
+	for sku, product := range loaded {
+		products[sku] = product
+	}
+
+	return products, nil
+}
+
+// GetLastKnownProductsBySkus возвращает товары из кэша, включая записи с истекшим TTL.
+func (s *CachedProductService) GetLastKnownProductsBySkus(_ context.Context, skus []int64) map[int64]*domain.Product {
+	products := make(map[int64]*domain.Product, len(skus))
+	for _, sku := range skus {
+		entry, ok := s.cache.GetStale(sku)
+		if ok && entry.product != nil {
+			products[sku] = copyProduct(entry.product)
+		}
+	}
+
+	return products
+}
+
+// loadMisses загружает товары, которых нет в кэше. К уже идущей загрузке SKU запрос присоединяется,
+// остальные SKU загружаются одним вызовом load. Ненайденные товары отсутствуют в результате.
+// Каждый запрос ждет результат до отмены своего ctx, отмена не прерывает общую загрузку.
+func (s *CachedProductService) loadMisses(ctx context.Context, skus []int64, load productLoader) (map[int64]*domain.Product, error) {
+	calls := make(map[int64]*productCall, len(skus))
+	owned := make([]int64, 0, len(skus))
+
+	s.mu.Lock()
+	for _, sku := range skus {
+		call, ok := s.inflight[sku]
+		if !ok {
+			call = &productCall{done: make(chan struct{})}
+			s.inflight[sku] = call
+			owned = append(owned, sku)
+		}
+		calls[sku] = call
+	}
+	s.mu.Unlock()
+
+	if len(owned) > 0 {
+		go s.runCalls(ctx, owned, calls, load)
+	}
+
+	products := make(map[int64]*domain.Product, len(skus))
+	for sku, call := range calls {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-call.done:
+		}
+
+		if call.err != nil {
+			return nil, call.err
+		}
+		if call.product != nil {
+			products[sku] = copyProduct(call.product)
+		}
+	}
+
+	return products, nil
+}
+
+// runCalls выполняет загрузку SKU, которые принадлежат этому запросу, кэширует результат
+// и сообщает его всем ожидающим. Результат ждут и другие запросы, поэтому загрузка не наследует
+// отмену ctx запроса, который ее начал, и ограничена только LoadTimeout.
+func (s *CachedProductService) runCalls(ctx context.Context, skus []int64, calls map[int64]*productCall, load productLoader) {
+	defer func() {
+		s.mu.Lock()
+		for _, sku := range skus {
+			delete(s.inflight, sku)
+		}
+		s.mu.Unlock()
+
+		for _, sku := range skus {
+			close(calls[sku].done)
+		}
+	}()
+
+	ctx = context.WithoutCancel(ctx)
+	if s.cfg.LoadTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.LoadTimeout)
+		defer cancel()
+	}
+
+	loaded, err := load(ctx, skus)
+
+	for _, sku := range skus {
+		call := calls[sku]
+		if err != nil {
+			call.err = err
+			continue
+		}
+
+		product, ok := loaded[sku]
+		if !ok {
+			s.cache.Set(sku, productCacheEntry{}, s.cfg.NegativeTTL)
+			continue
+		}
+
+		call.product = copyProduct(product)
+		s.cache.Set(sku, productCacheEntry{product: copyProduct(product)}, s.cfg.TTL)
+	}
+}
+
+func (s *CachedProductService) loadProduct(ctx context.Context, skus []int64) (map[int64]*domain.Product, error) {
+	product, err := s.productService.GetProductBySku(ctx, skus[0])
+	if errors.Is(err, domain.ErrProductNotFound) {
+		return map[int64]*domain.Product{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("productService.GetProductBySku: %w", err)
+	}
+
+	return map[int64]*domain.Product{skus[0]: product}, nil
+}
+
+func (s *CachedProductService) loadProducts(ctx context.Context, skus []int64) (map[int64]*domain.Product, error) {
+	products, err := s.productService.GetProductsBySkus(ctx, skus)
+	if err != nil {
+		return nil, fmt.Errorf("productService.GetProductsBySkus: %w", err)
+	}
+
+	return products, nil
+}
+
+func copyProduct(product *domain.Product) *domain.Product {
+	productCopy := *product
+	return &productCopy
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"route256/cart/internal/domain"
+	mock "route256/cart/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testComponentCPS struct {
+	productServMock *mock.ProductServiceMock
+	cachedService   *CachedProductService
+}
+
+func newTestComponentCPS(t *testing.T) *testComponentCPS {
+	mc := minimock.NewController(t)
+	productServMock := mock.NewProductServiceMock(mc)
+	cachedService := NewCachedProductService(productServMock, ProductCacheConfig{
+		Capacity:    10,
+		TTL:         time.Minute,
+		NegativeTTL: time.Minute,
+		LoadTimeout: time.Second,
+	})
+
+	return &testComponentCPS{
+		productServMock: productServMock,
+		cachedService:   cachedService,
+	}
+}
+
+func TestCachedProductService_GetProductBySku(t *testing.T) {
+	t.Parallel()
+
+	t.Run("second call served from cache", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+		product := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+
+		tc.productServMock.GetProductBySkuMock.Times(1).Return(product, nil)
+
+		for range 2 {
+			got, err := tc.cachedService.GetProductBySku(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, product, got)
+		}
+	})
+
+	t.Run("not found cached", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+
+		tc.productServMock.GetProductBySkuMock.Times(1).Return(nil, domain.ErrProductNotFound)
+
+		for range 2 {
+			_, err := tc.cachedService.GetProductBySku(ctx, 1)
+			require.ErrorIs(t, err, domain.ErrProductNotFound)
+		}
+	})
+
+	t.Run("other errors not cached", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+
+		tc.productServMock.GetProductBySkuMock.Times(2).Return(nil, errors.New("unavailable"))
+
+		for range 2 {
+			_, err := tc.cachedService.GetProductBySku(ctx, 1)
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("concurrent misses coalesced", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+		product := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+		release := make(chan struct{})
+
+		tc.productServMock.GetProductBySkuMock.Times(1).Set(func(_ context.Context, _ int64) (*domain.Product, error) {
+			<-release
+			return product, nil
+		})
+
+		const callers = 5
+		var wg sync.WaitGroup
+		for range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				got, err := tc.cachedService.GetProductBySku(ctx, 1)
+				assert.NoError(t, err)
+				assert.Equal(t, product, got)
+			}()
+		}
+
+		// Ждем, пока первый вызов дойдет до сервиса, остальные присоединятся к нему.
+		require.Eventually(t, func() bool {
+			return tc.productServMock.GetProductBySkuBeforeCounter() == 1
+		}, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("canceled caller does not fail other waiters", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		product := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+		release := make(chan struct{})
+
+		tc.productServMock.GetProductBySkuMock.Times(1).Set(func(ctx context.Context, _ int64) (*domain.Product, error) {
+			<-release
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return product, nil
+		})
+
+		firstCtx, cancelFirst := context.WithCancel(context.Background())
+		firstDone := make(chan error, 1)
+		go func() {
+			_, err := tc.cachedService.GetProductBySku(firstCtx, 1)
+			firstDone <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			return tc.productServMock.GetProductBySkuBeforeCounter() == 1
+		}, time.Second, time.Millisecond)
+
+		secondDone := make(chan struct{})
+		go func() {
+			defer close(secondDone)
+
+			got, err := tc.cachedService.GetProductBySku(context.Background(), 1)
+			assert.NoError(t, err)
+			assert.Equal(t, product, got)
+		}()
+		time.Sleep(10 * time.Millisecond)
+
+		cancelFirst()
+		require.ErrorIs(t, <-firstDone, context.Canceled)
+
+		close(release)
+		<-secondDone
+	})
+
+	t.Run("returned product is a copy", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+
+		tc.productServMock.GetProductBySkuMock.Times(1).Return(&domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}, nil)
+
+		got, err := tc.cachedService.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		got.Price = rub(0)
+
+		got, err = tc.cachedService.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, rub(100), got.Price)
+	})
+}
+
+func TestCachedProductService_GetProductsBySkus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("only misses requested", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+		product1 := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+		product2 := &domain.Product{Sku: 2, Name: "name 2", Price: rub(200)}
+
+		tc.productServMock.GetProductBySkuMock.When(minimock.AnyContext, 1).Then(product1, nil)
+		tc.productServMock.GetProductsBySkusMock.
+			When(minimock.AnyContext, []int64{2, 3}).
+			Then(map[int64]*domain.Product{2: product2}, nil)
+
+		_, err := tc.cachedService.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+
+		for range 2 {
+			products, err := tc.cachedService.GetProductsBySkus(ctx, []int64{1, 2, 3})
+			require.NoError(t, err)
+			assert.Equal(t, map[int64]*domain.Product{1: product1, 2: product2}, products)
+		}
+	})
+
+	t.Run("concurrent batches share in-flight misses", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+		product1 := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+		product2 := &domain.Product{Sku: 2, Name: "name 2", Price: rub(200)}
+		release := make(chan struct{})
+
+		tc.productServMock.GetProductsBySkusMock.Times(1).Set(func(_ context.Context, skus []int64) (map[int64]*domain.Product, error) {
+			<-release
+			assert.Equal(t, []int64{1, 2}, skus)
+			return map[int64]*domain.Product{1: product1, 2: product2}, nil
+		})
+		tc.productServMock.GetProductBySkuMock.Optional().Return(nil, errors.New("unexpected single lookup"))
+
+		const callers = 5
+		var wg sync.WaitGroup
+		for range callers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				products, err := tc.cachedService.GetProductsBySkus(ctx, []int64{1, 2})
+				assert.NoError(t, err)
+				assert.Equal(t, map[int64]*domain.Product{1: product1, 2: product2}, products)
+			}()
+		}
+
+		// Ждем, пока первая пачка дойдет до сервиса, остальные запросы присоединятся к ней.
+		require.Eventually(t, func() bool {
+			return tc.productServMock.GetProductsBySkusBeforeCounter() == 1
+		}, time.Second, time.Millisecond)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			got, err := tc.cachedService.GetProductBySku(ctx, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, product2, got)
+		}()
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("error not cached", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentCPS(t)
+
+		ctx := context.Background()
+
+		tc.productServMock.GetProductsBySkusMock.Times(2).Return(nil, errors.New("unavailable"))
+
+		for range 2 {
+			_, err := tc.cachedService.GetProductsBySkus(ctx, []int64{1})
+			require.Error(t, err)
+		}
+	})
+}
+
+func TestCachedProductService_GetLastKnownProductsBySkus(t *testing.T) {
+	t.Parallel()
+
+	tc := newTestComponentCPS(t)
+
+	ctx := context.Background()
+	product := &domain.Product{Sku: 1, Name: "name 1", Price: rub(100)}
+
+	tc.productServMock.GetProductsBySkusMock.
+		When(minimock.AnyContext, []int64{1, 2}).
+		Then(map[int64]*domain.Product{1: product}, nil)
+
+	_, err := tc.cachedService.GetProductsBySkus(ctx, []int64{1, 2})
+	require.NoError(t, err)
+
+	products := tc.cachedService.GetLastKnownProductsBySkus(ctx, []int64{1, 2, 3})
+	assert.Equal(t, map[int64]*domain.Product{1: product}, products)
+}
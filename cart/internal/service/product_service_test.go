@@ -1,7 +1,9 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"route256/cart/internal/domain"
@@ -24,7 +26,11 @@ func newTestComponentPS(t *testing.T) *testComponentPS {
 	mc := minimock.NewController(t)
 	httpClientMock := mock.NewHTTPClientMock(mc)
 	rateLimiterMock := mock.NewRateLimiterMock(mc)
-	productService := NewProductServiceHTTP(httpClientMock, rateLimiterMock, "token", "url-test")
+	productService := NewProductServiceHTTP(httpClientMock, rateLimiterMock, "token", "url-test", ProductBatchConfig{
+		Path:         "/product/batch",
+		MaxBatchSize: 2,
+		MaxFanOut:    2,
+	})
 
 	return &testComponentPS{
 		httpClientMock:  httpClientMock,
@@ -48,12 +54,12 @@ func TestProductServiceHttp_GetProductBySku(t *testing.T) {
 		}
 
 		tc.httpClientMock.DoMock.Return(response, nil)
-		tc.rateLimiterMock.AcquireMock.Return()
+		tc.rateLimiterMock.AcquireMock.Return(nil)
 
 		product, err := tc.productService.GetProductBySku(context.Background(), 12345)
 		require.NoError(t, err)
 
-		expected := &domain.Product{Name: "TestProduct", Price: 100, Sku: 12345}
+		expected := &domain.Product{Name: "TestProduct", Price: rub(100), Sku: 12345}
 		assert.Equal(t, expected, product)
 	})
 
@@ -68,11 +74,130 @@ func TestProductServiceHttp_GetProductBySku(t *testing.T) {
 		}
 
 		tc.httpClientMock.DoMock.Return(response, nil)
-		tc.rateLimiterMock.AcquireMock.Return()
+		tc.rateLimiterMock.AcquireMock.Return(nil)
 
 		product, err := tc.productService.GetProductBySku(context.Background(), 99999)
 		require.Error(t, err)
 
 		assert.Nil(t, product)
 	})
+
+	t.Run("rate limiter wait canceled", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+
+		tc.rateLimiterMock.AcquireMock.Return(context.Canceled)
+
+		product, err := tc.productService.GetProductBySku(context.Background(), 12345)
+		require.ErrorIs(t, err, context.Canceled)
+
+		assert.Nil(t, product)
+	})
+}
+
+func TestProductServiceHttp_GetProductsBySkus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("batch endpoint costs one rate limit slot per batch", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+
+		tc.rateLimiterMock.AcquireMock.Times(2).Return(nil)
+		tc.httpClientMock.DoMock.Set(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "url-test/product/batch", req.URL.String())
+
+			request := &GetProductsRequest{}
+			require.NoError(t, json.NewDecoder(req.Body).Decode(request))
+
+			products := make([]*GetProductResponse, 0, len(request.Skus))
+			for _, sku := range request.Skus {
+				if sku == 3 {
+					continue
+				}
+				products = append(products, &GetProductResponse{Name: "name", Price: 100, Sku: sku})
+			}
+
+			body, err := json.Marshal(&GetProductsResponse{Products: products})
+			require.NoError(t, err)
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(body)),
+			}, nil
+		})
+
+		products, err := tc.productService.GetProductsBySkus(context.Background(), []int64{1, 2, 3})
+		require.NoError(t, err)
+
+		assert.Len(t, products, 2)
+		assert.Equal(t, &domain.Product{Name: "name", Price: rub(100), Sku: 2}, products[2])
+		assert.NotContains(t, products, int64(3))
+	})
+
+	t.Run("fallback to fan-out when batch endpoint unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+
+		tc.rateLimiterMock.AcquireMock.Return(nil)
+		tc.httpClientMock.DoMock.Set(func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodPost {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+			}
+
+			if strings.HasSuffix(req.URL.Path, "/2") {
+				return &http.Response{StatusCode: http.StatusNotFound, Body: http.NoBody}, nil
+			}
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"name":"name","price":100,"sku":1}`)),
+			}, nil
+		})
+
+		for range 2 {
+			products, err := tc.productService.GetProductsBySkus(context.Background(), []int64{1, 2})
+			require.NoError(t, err)
+
+			assert.Len(t, products, 1)
+			assert.Contains(t, products, int64(1))
+		}
+
+		// batch-эндпоинт запрашивается только один раз
+		assert.EqualValues(t, 1+2+2, tc.httpClientMock.DoAfterCounter())
+	})
+
+	t.Run("fan-out reports every failure", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+		tc.productService.batch.Path = ""
+
+		tc.rateLimiterMock.AcquireMock.Return(nil)
+		tc.httpClientMock.DoMock.Return(&http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody}, nil)
+
+		_, err := tc.productService.GetProductsBySkus(context.Background(), []int64{1, 2})
+		require.ErrorIs(t, err, ErrNotOk)
+
+		assert.Contains(t, err.Error(), "s.GetProductBySku(1)")
+		assert.Contains(t, err.Error(), "s.GetProductBySku(2)")
+		assert.EqualValues(t, 2, tc.httpClientMock.DoAfterCounter())
+	})
+
+	t.Run("error from batch endpoint", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+
+		tc.rateLimiterMock.AcquireMock.Return(nil)
+		tc.httpClientMock.DoMock.Return(&http.Response{StatusCode: http.StatusInternalServerError, Body: http.NoBody}, nil)
+
+		products, err := tc.productService.GetProductsBySkus(context.Background(), []int64{1})
+		require.ErrorIs(t, err, ErrNotOk)
+
+		assert.Nil(t, products)
+	})
 }
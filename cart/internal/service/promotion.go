@@ -0,0 +1,86 @@
+package service
+
+import (
+	"fmt"
+	"route256/cart/internal/domain"
+)
+
+// applyPromotion рассчитывает скидки по промокоду для корзины с уже посчитанной суммой товаров.
+// Правила применяются по порядку, каждое к сумме, оставшейся после предыдущих скидок.
+// Цены товаров пересчитываются в валюту корзины через convert.
+// Возвращает false, если корзина не удовлетворяет условиям промокода или скидка получилась нулевой.
+func applyPromotion(promo *domain.Promotion, cart *domain.Cart, convert moneyConverter) ([]domain.Discount, bool, error) {
+	for _, rule := range promo.Rules {
+		if rule.Kind == domain.PromoMinTotal && cart.Subtotal.Amount < rule.MinTotal {
+			return nil, false, nil
+		}
+	}
+
+	remaining := cart.Subtotal
+	discounts := make([]domain.Discount, 0, len(promo.Rules))
+	for _, rule := range promo.Rules {
+		amount, err := ruleDiscount(rule, cart, remaining, convert)
+		if err != nil {
+			return nil, false, fmt.Errorf("ruleDiscount(%s): %w", rule.Kind, err)
+		}
+
+		amount, err = amount.Min(remaining)
+		if err != nil {
+			return nil, false, fmt.Errorf("amount.Min: %w", err)
+		}
+		if amount.Amount <= 0 {
+			continue
+		}
+
+		remaining, err = remaining.Sub(amount)
+		if err != nil {
+			return nil, false, fmt.Errorf("remaining.Sub: %w", err)
+		}
+
+		discounts = append(discounts, domain.Discount{
+			PromoCode:   promo.Code,
+			Description: promo.Description,
+			Kind:        rule.Kind,
+			Amount:      amount,
+		})
+	}
+
+	if len(discounts) == 0 {
+		return nil, false, nil
+	}
+
+	return discounts, true, nil
+}
+
+func ruleDiscount(rule domain.PromoRule, cart *domain.Cart, remaining domain.Money, convert moneyConverter) (domain.Money, error) {
+	zero := domain.ZeroMoney(remaining.Currency)
+
+	switch rule.Kind {
+	case domain.PromoPercentage:
+		return remaining.Percent(rule.Percent), nil
+	case domain.PromoFixedAmount:
+		return domain.NewMoney(rule.Amount, remaining.Currency), nil
+	case domain.PromoBuyNGetM:
+		if rule.Buy+rule.Free == 0 {
+			return zero, nil
+		}
+
+		for _, item := range cart.Items {
+			if item.Sku != rule.Sku || item.Availability == domain.ItemUnavailable {
+				continue
+			}
+
+			freeCount := item.Count / (rule.Buy + rule.Free) * rule.Free
+			amount, err := item.Price.Mul(freeCount)
+			if err != nil {
+				return domain.Money{}, fmt.Errorf("item.Price.Mul: %w", err)
+			}
+
+			return convert(amount)
+		}
+
+		return zero, nil
+	default:
+		return zero, nil
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"testing"
+
+	"route256/cart/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func noConversion(m domain.Money) (domain.Money, error) {
+	return m, nil
+}
+
+func TestApplyPromotion(t *testing.T) {
+	t.Parallel()
+
+	cart := &domain.Cart{
+		Items: []*domain.CartItem{
+			{Sku: 1, Count: 5, Price: rub(100)},
+			{Sku: 2, Count: 1, Price: rub(300)},
+		},
+		Subtotal: rub(5*100 + 300),
+	}
+
+	tests := []struct {
+		name        string
+		rules       []domain.PromoRule
+		wantAmounts []int64
+		wantOk      bool
+	}{
+		{
+			name:        "percentage",
+			rules:       []domain.PromoRule{{Kind: domain.PromoPercentage, Percent: 25}},
+			wantAmounts: []int64{200},
+			wantOk:      true,
+		},
+		{
+			name:        "fixed amount is limited by cart total",
+			rules:       []domain.PromoRule{{Kind: domain.PromoFixedAmount, Amount: 1000}},
+			wantAmounts: []int64{800},
+			wantOk:      true,
+		},
+		{
+			name:        "buy 2 get 1",
+			rules:       []domain.PromoRule{{Kind: domain.PromoBuyNGetM, Sku: 1, Buy: 2, Free: 1}},
+			wantAmounts: []int64{100},
+			wantOk:      true,
+		},
+		{
+			name:   "buy n get m for sku not in cart",
+			rules:  []domain.PromoRule{{Kind: domain.PromoBuyNGetM, Sku: 3, Buy: 1, Free: 1}},
+			wantOk: false,
+		},
+		{
+			name: "min total satisfied",
+			rules: []domain.PromoRule{
+				{Kind: domain.PromoMinTotal, MinTotal: 800},
+				{Kind: domain.PromoFixedAmount, Amount: 50},
+			},
+			wantAmounts: []int64{50},
+			wantOk:      true,
+		},
+		{
+			name: "min total not satisfied",
+			rules: []domain.PromoRule{
+				{Kind: domain.PromoMinTotal, MinTotal: 801},
+				{Kind: domain.PromoFixedAmount, Amount: 50},
+			},
+			wantOk: false,
+		},
+		{
+			name: "rules applied in order to remaining total",
+			rules: []domain.PromoRule{
+				{Kind: domain.PromoFixedAmount, Amount: 300},
+				{Kind: domain.PromoPercentage, Percent: 10},
+			},
+			wantAmounts: []int64{300, 50},
+			wantOk:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			discounts, ok, err := applyPromotion(&domain.Promotion{Code: "PROMO", Rules: tt.rules}, cart, noConversion)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOk, ok)
+
+			amounts := make([]int64, 0, len(discounts))
+			for _, discount := range discounts {
+				assert.Equal(t, "PROMO", discount.PromoCode)
+				assert.Equal(t, domain.DefaultCurrency, discount.Amount.Currency)
+				amounts = append(amounts, discount.Amount.Amount)
+			}
+			if tt.wantOk {
+				assert.Equal(t, tt.wantAmounts, amounts)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE rate_limit_buckets (
+    name TEXT PRIMARY KEY,
+    rate_limit DOUBLE PRECISION NOT NULL,
+    burst INTEGER NOT NULL,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE rate_limit_buckets;
+-- +goose StatementEnd
@@ -33,6 +33,13 @@ type CartRepositoryMock struct {
 	beforeDeleteCartItemCounter uint64
 	DeleteCartItemMock          mCartRepositoryMockDeleteCartItem
 
+	funcDeleteSavedItem          func(ctx context.Context, userID int64, skuID int64) (err error)
+	funcDeleteSavedItemOrigin    string
+	inspectFuncDeleteSavedItem   func(ctx context.Context, userID int64, skuID int64)
+	afterDeleteSavedItemCounter  uint64
+	beforeDeleteSavedItemCounter uint64
+	DeleteSavedItemMock          mCartRepositoryMockDeleteSavedItem
+
 	funcGetCartByUserIDOrderBySku          func(ctx context.Context, userID int64) (cp1 *domain.Cart, err error)
 	funcGetCartByUserIDOrderBySkuOrigin    string
 	inspectFuncGetCartByUserIDOrderBySku   func(ctx context.Context, userID int64)
@@ -40,6 +47,41 @@ type CartRepositoryMock struct {
 	beforeGetCartByUserIDOrderBySkuCounter uint64
 	GetCartByUserIDOrderBySkuMock          mCartRepositoryMockGetCartByUserIDOrderBySku
 
+	funcGetSavedItemsOrderBySku          func(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error)
+	funcGetSavedItemsOrderBySkuOrigin    string
+	inspectFuncGetSavedItemsOrderBySku   func(ctx context.Context, userID int64)
+	afterGetSavedItemsOrderBySkuCounter  uint64
+	beforeGetSavedItemsOrderBySkuCounter uint64
+	GetSavedItemsOrderBySkuMock          mCartRepositoryMockGetSavedItemsOrderBySku
+
+	funcMergeCarts          func(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy) (err error)
+	funcMergeCartsOrigin    string
+	inspectFuncMergeCarts   func(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy)
+	afterMergeCartsCounter  uint64
+	beforeMergeCartsCounter uint64
+	MergeCartsMock          mCartRepositoryMockMergeCarts
+
+	funcMoveCartItemToSaved          func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)
+	funcMoveCartItemToSavedOrigin    string
+	inspectFuncMoveCartItemToSaved   func(ctx context.Context, userID int64, skuID int64)
+	afterMoveCartItemToSavedCounter  uint64
+	beforeMoveCartItemToSavedCounter uint64
+	MoveCartItemToSavedMock          mCartRepositoryMockMoveCartItemToSaved
+
+	funcMoveSavedItemToCart          func(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money) (cp1 *domain.CartItem, err error)
+	funcMoveSavedItemToCartOrigin    string
+	inspectFuncMoveSavedItemToCart   func(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money)
+	afterMoveSavedItemToCartCounter  uint64
+	beforeMoveSavedItemToCartCounter uint64
+	MoveSavedItemToCartMock          mCartRepositoryMockMoveSavedItemToCart
+
+	funcSetPromoCode          func(ctx context.Context, userID int64, code string) (err error)
+	funcSetPromoCodeOrigin    string
+	inspectFuncSetPromoCode   func(ctx context.Context, userID int64, code string)
+	afterSetPromoCodeCounter  uint64
+	beforeSetPromoCodeCounter uint64
+	SetPromoCodeMock          mCartRepositoryMockSetPromoCode
+
 	funcUpsertCartItem          func(ctx context.Context, userID int64, newItem *domain.CartItem) (cp1 *domain.CartItem, err error)
 	funcUpsertCartItemOrigin    string
 	inspectFuncUpsertCartItem   func(ctx context.Context, userID int64, newItem *domain.CartItem)
@@ -62,9 +104,27 @@ func NewCartRepositoryMock(t minimock.Tester) *CartRepositoryMock {
 	m.DeleteCartItemMock = mCartRepositoryMockDeleteCartItem{mock: m}
 	m.DeleteCartItemMock.callArgs = []*CartRepositoryMockDeleteCartItemParams{}
 
+	m.DeleteSavedItemMock = mCartRepositoryMockDeleteSavedItem{mock: m}
+	m.DeleteSavedItemMock.callArgs = []*CartRepositoryMockDeleteSavedItemParams{}
+
 	m.GetCartByUserIDOrderBySkuMock = mCartRepositoryMockGetCartByUserIDOrderBySku{mock: m}
 	m.GetCartByUserIDOrderBySkuMock.callArgs = []*CartRepositoryMockGetCartByUserIDOrderBySkuParams{}
 
+	m.GetSavedItemsOrderBySkuMock = mCartRepositoryMockGetSavedItemsOrderBySku{mock: m}
+	m.GetSavedItemsOrderBySkuMock.callArgs = []*CartRepositoryMockGetSavedItemsOrderBySkuParams{}
+
+	m.MergeCartsMock = mCartRepositoryMockMergeCarts{mock: m}
+	m.MergeCartsMock.callArgs = []*CartRepositoryMockMergeCartsParams{}
+
+	m.MoveCartItemToSavedMock = mCartRepositoryMockMoveCartItemToSaved{mock: m}
+	m.MoveCartItemToSavedMock.callArgs = []*CartRepositoryMockMoveCartItemToSavedParams{}
+
+	m.MoveSavedItemToCartMock = mCartRepositoryMockMoveSavedItemToCart{mock: m}
+	m.MoveSavedItemToCartMock.callArgs = []*CartRepositoryMockMoveSavedItemToCartParams{}
+
+	m.SetPromoCodeMock = mCartRepositoryMockSetPromoCode{mock: m}
+	m.SetPromoCodeMock.callArgs = []*CartRepositoryMockSetPromoCodeParams{}
+
 	m.UpsertCartItemMock = mCartRepositoryMockUpsertCartItem{mock: m}
 	m.UpsertCartItemMock.callArgs = []*CartRepositoryMockUpsertCartItemParams{}
 
@@ -788,6 +848,379 @@ func (m *CartRepositoryMock) MinimockDeleteCartItemInspect() {
 	}
 }
 
+type mCartRepositoryMockDeleteSavedItem struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockDeleteSavedItemExpectation
+	expectations       []*CartRepositoryMockDeleteSavedItemExpectation
+
+	callArgs []*CartRepositoryMockDeleteSavedItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockDeleteSavedItemExpectation specifies expectation struct of the CartRepository.DeleteSavedItem
+type CartRepositoryMockDeleteSavedItemExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockDeleteSavedItemParams
+	paramPtrs          *CartRepositoryMockDeleteSavedItemParamPtrs
+	expectationOrigins CartRepositoryMockDeleteSavedItemExpectationOrigins
+	results            *CartRepositoryMockDeleteSavedItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockDeleteSavedItemParams contains parameters of the CartRepository.DeleteSavedItem
+type CartRepositoryMockDeleteSavedItemParams struct {
+	ctx    context.Context
+	userID int64
+	skuID  int64
+}
+
+// CartRepositoryMockDeleteSavedItemParamPtrs contains pointers to parameters of the CartRepository.DeleteSavedItem
+type CartRepositoryMockDeleteSavedItemParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	skuID  *int64
+}
+
+// CartRepositoryMockDeleteSavedItemResults contains results of the CartRepository.DeleteSavedItem
+type CartRepositoryMockDeleteSavedItemResults struct {
+	err error
+}
+
+// CartRepositoryMockDeleteSavedItemOrigins contains origins of expectations of the CartRepository.DeleteSavedItem
+type CartRepositoryMockDeleteSavedItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Optional() *mCartRepositoryMockDeleteSavedItem {
+	mmDeleteSavedItem.optional = true
+	return mmDeleteSavedItem
+}
+
+// Expect sets up expected params for CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Expect(ctx context.Context, userID int64, skuID int64) *mCartRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartRepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSavedItem.defaultExpectation.params = &CartRepositoryMockDeleteSavedItemParams{ctx, userID, skuID}
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedItem.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedItem.defaultExpectation.params) {
+			mmDeleteSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartRepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) ExpectUserIDParam2(userID int64) *mCartRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartRepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) ExpectSkuIDParam3(skuID int64) *mCartRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartRepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartRepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mCartRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.DeleteSavedItem")
+	}
+
+	mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem = f
+
+	return mmDeleteSavedItem
+}
+
+// Return sets up results that will be returned by CartRepository.DeleteSavedItem
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Return(err error) *CartRepositoryMock {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartRepositoryMockDeleteSavedItemExpectation{mock: mmDeleteSavedItem.mock}
+	}
+	mmDeleteSavedItem.defaultExpectation.results = &CartRepositoryMockDeleteSavedItemResults{err}
+	mmDeleteSavedItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// Set uses given function f to mock the CartRepository.DeleteSavedItem method
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Set(f func(ctx context.Context, userID int64, skuID int64) (err error)) *CartRepositoryMock {
+	if mmDeleteSavedItem.defaultExpectation != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Default expectation is already set for the CartRepository.DeleteSavedItem method")
+	}
+
+	if len(mmDeleteSavedItem.expectations) > 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Some expectations are already set for the CartRepository.DeleteSavedItem method")
+	}
+
+	mmDeleteSavedItem.mock.funcDeleteSavedItem = f
+	mmDeleteSavedItem.mock.funcDeleteSavedItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// When sets expectation for the CartRepository.DeleteSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) When(ctx context.Context, userID int64, skuID int64) *CartRepositoryMockDeleteSavedItemExpectation {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartRepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockDeleteSavedItemExpectation{
+		mock:               mmDeleteSavedItem.mock,
+		params:             &CartRepositoryMockDeleteSavedItemParams{ctx, userID, skuID},
+		expectationOrigins: CartRepositoryMockDeleteSavedItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedItem.expectations = append(mmDeleteSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.DeleteSavedItem return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockDeleteSavedItemExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockDeleteSavedItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.DeleteSavedItem should be invoked
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Times(n uint64) *mCartRepositoryMockDeleteSavedItem {
+	if n == 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Times of CartRepositoryMock.DeleteSavedItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedItem.expectedInvocations, n)
+	mmDeleteSavedItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem
+}
+
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) invocationsDone() bool {
+	if len(mmDeleteSavedItem.expectations) == 0 && mmDeleteSavedItem.defaultExpectation == nil && mmDeleteSavedItem.mock.funcDeleteSavedItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.mock.afterDeleteSavedItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedItem implements mm_service.CartRepository
+func (mmDeleteSavedItem *CartRepositoryMock) DeleteSavedItem(ctx context.Context, userID int64, skuID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter, 1)
+
+	mmDeleteSavedItem.t.Helper()
+
+	if mmDeleteSavedItem.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.inspectFuncDeleteSavedItem(ctx, userID, skuID)
+	}
+
+	mm_params := CartRepositoryMockDeleteSavedItemParams{ctx, userID, skuID}
+
+	// Record call args
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Lock()
+	mmDeleteSavedItem.DeleteSavedItemMock.callArgs = append(mmDeleteSavedItem.DeleteSavedItemMock.callArgs, &mm_params)
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedItem.DeleteSavedItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockDeleteSavedItemParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedItem.t.Errorf("CartRepositoryMock.DeleteSavedItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSavedItem.t.Errorf("CartRepositoryMock.DeleteSavedItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteSavedItem.t.Errorf("CartRepositoryMock.DeleteSavedItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedItem.t.Errorf("CartRepositoryMock.DeleteSavedItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedItem.t.Fatal("No results are set for the CartRepositoryMock.DeleteSavedItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedItem.funcDeleteSavedItem != nil {
+		return mmDeleteSavedItem.funcDeleteSavedItem(ctx, userID, skuID)
+	}
+	mmDeleteSavedItem.t.Fatalf("Unexpected call to CartRepositoryMock.DeleteSavedItem. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// DeleteSavedItemAfterCounter returns a count of finished CartRepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartRepositoryMock) DeleteSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter)
+}
+
+// DeleteSavedItemBeforeCounter returns a count of CartRepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartRepositoryMock) DeleteSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.DeleteSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedItem *mCartRepositoryMockDeleteSavedItem) Calls() []*CartRepositoryMockDeleteSavedItemParams {
+	mmDeleteSavedItem.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockDeleteSavedItemParams, len(mmDeleteSavedItem.callArgs))
+	copy(argCopy, mmDeleteSavedItem.callArgs)
+
+	mmDeleteSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedItemDone returns true if the count of the DeleteSavedItem invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockDeleteSavedItemDone() bool {
+	if m.DeleteSavedItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedItemMock.invocationsDone()
+}
+
+// MinimockDeleteSavedItemInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockDeleteSavedItemInspect() {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteSavedItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedItemCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && afterDeleteSavedItemCounter < 1 {
+		if m.DeleteSavedItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteSavedItem at\n%s", m.DeleteSavedItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.DeleteSavedItem at\n%s with params: %#v", m.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && afterDeleteSavedItemCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.DeleteSavedItem at\n%s", m.funcDeleteSavedItemOrigin)
+	}
+
+	if !m.DeleteSavedItemMock.invocationsDone() && afterDeleteSavedItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.DeleteSavedItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedItemMock.expectedInvocations), m.DeleteSavedItemMock.expectedInvocationsOrigin, afterDeleteSavedItemCounter)
+	}
+}
+
 type mCartRepositoryMockGetCartByUserIDOrderBySku struct {
 	optional           bool
 	mock               *CartRepositoryMock
@@ -1131,56 +1564,53 @@ func (m *CartRepositoryMock) MinimockGetCartByUserIDOrderBySkuInspect() {
 	}
 }
 
-type mCartRepositoryMockUpsertCartItem struct {
+type mCartRepositoryMockGetSavedItemsOrderBySku struct {
 	optional           bool
 	mock               *CartRepositoryMock
-	defaultExpectation *CartRepositoryMockUpsertCartItemExpectation
-	expectations       []*CartRepositoryMockUpsertCartItemExpectation
+	defaultExpectation *CartRepositoryMockGetSavedItemsOrderBySkuExpectation
+	expectations       []*CartRepositoryMockGetSavedItemsOrderBySkuExpectation
 
-	callArgs []*CartRepositoryMockUpsertCartItemParams
+	callArgs []*CartRepositoryMockGetSavedItemsOrderBySkuParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CartRepositoryMockUpsertCartItemExpectation specifies expectation struct of the CartRepository.UpsertCartItem
-type CartRepositoryMockUpsertCartItemExpectation struct {
+// CartRepositoryMockGetSavedItemsOrderBySkuExpectation specifies expectation struct of the CartRepository.GetSavedItemsOrderBySku
+type CartRepositoryMockGetSavedItemsOrderBySkuExpectation struct {
 	mock               *CartRepositoryMock
-	params             *CartRepositoryMockUpsertCartItemParams
-	paramPtrs          *CartRepositoryMockUpsertCartItemParamPtrs
-	expectationOrigins CartRepositoryMockUpsertCartItemExpectationOrigins
-	results            *CartRepositoryMockUpsertCartItemResults
+	params             *CartRepositoryMockGetSavedItemsOrderBySkuParams
+	paramPtrs          *CartRepositoryMockGetSavedItemsOrderBySkuParamPtrs
+	expectationOrigins CartRepositoryMockGetSavedItemsOrderBySkuExpectationOrigins
+	results            *CartRepositoryMockGetSavedItemsOrderBySkuResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CartRepositoryMockUpsertCartItemParams contains parameters of the CartRepository.UpsertCartItem
-type CartRepositoryMockUpsertCartItemParams struct {
-	ctx     context.Context
-	userID  int64
-	newItem *domain.CartItem
+// CartRepositoryMockGetSavedItemsOrderBySkuParams contains parameters of the CartRepository.GetSavedItemsOrderBySku
+type CartRepositoryMockGetSavedItemsOrderBySkuParams struct {
+	ctx    context.Context
+	userID int64
 }
 
-// CartRepositoryMockUpsertCartItemParamPtrs contains pointers to parameters of the CartRepository.UpsertCartItem
-type CartRepositoryMockUpsertCartItemParamPtrs struct {
-	ctx     *context.Context
-	userID  *int64
-	newItem **domain.CartItem
+// CartRepositoryMockGetSavedItemsOrderBySkuParamPtrs contains pointers to parameters of the CartRepository.GetSavedItemsOrderBySku
+type CartRepositoryMockGetSavedItemsOrderBySkuParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
 }
 
-// CartRepositoryMockUpsertCartItemResults contains results of the CartRepository.UpsertCartItem
-type CartRepositoryMockUpsertCartItemResults struct {
-	cp1 *domain.CartItem
-	err error
+// CartRepositoryMockGetSavedItemsOrderBySkuResults contains results of the CartRepository.GetSavedItemsOrderBySku
+type CartRepositoryMockGetSavedItemsOrderBySkuResults struct {
+	cpa1 []*domain.CartItem
+	err  error
 }
 
-// CartRepositoryMockUpsertCartItemOrigins contains origins of expectations of the CartRepository.UpsertCartItem
-type CartRepositoryMockUpsertCartItemExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originUserID  string
-	originNewItem string
+// CartRepositoryMockGetSavedItemsOrderBySkuOrigins contains origins of expectations of the CartRepository.GetSavedItemsOrderBySku
+type CartRepositoryMockGetSavedItemsOrderBySkuExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1188,38 +1618,1940 @@ type CartRepositoryMockUpsertCartItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) Optional() *mCartRepositoryMockUpsertCartItem {
-	mmUpsertCartItem.optional = true
-	return mmUpsertCartItem
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Optional() *mCartRepositoryMockGetSavedItemsOrderBySku {
+	mmGetSavedItemsOrderBySku.optional = true
+	return mmGetSavedItemsOrderBySku
 }
 
-// Expect sets up expected params for CartRepository.UpsertCartItem
-func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) Expect(ctx context.Context, userID int64, newItem *domain.CartItem) *mCartRepositoryMockUpsertCartItem {
-	if mmUpsertCartItem.mock.funcUpsertCartItem != nil {
-		mmUpsertCartItem.mock.t.Fatalf("CartRepositoryMock.UpsertCartItem mock is already set by Set")
+// Expect sets up expected params for CartRepository.GetSavedItemsOrderBySku
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Expect(ctx context.Context, userID int64) *mCartRepositoryMockGetSavedItemsOrderBySku {
+	if mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Set")
 	}
 
-	if mmUpsertCartItem.defaultExpectation == nil {
-		mmUpsertCartItem.defaultExpectation = &CartRepositoryMockUpsertCartItemExpectation{}
+	if mmGetSavedItemsOrderBySku.defaultExpectation == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation = &CartRepositoryMockGetSavedItemsOrderBySkuExpectation{}
 	}
 
-	if mmUpsertCartItem.defaultExpectation.paramPtrs != nil {
-		mmUpsertCartItem.mock.t.Fatalf("CartRepositoryMock.UpsertCartItem mock is already set by ExpectParams functions")
+	if mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by ExpectParams functions")
 	}
 
-	mmUpsertCartItem.defaultExpectation.params = &CartRepositoryMockUpsertCartItemParams{ctx, userID, newItem}
-	mmUpsertCartItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmUpsertCartItem.expectations {
-		if minimock.Equal(e.params, mmUpsertCartItem.defaultExpectation.params) {
-			mmUpsertCartItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertCartItem.defaultExpectation.params)
+	mmGetSavedItemsOrderBySku.defaultExpectation.params = &CartRepositoryMockGetSavedItemsOrderBySkuParams{ctx, userID}
+	mmGetSavedItemsOrderBySku.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedItemsOrderBySku.expectations {
+		if minimock.Equal(e.params, mmGetSavedItemsOrderBySku.defaultExpectation.params) {
+			mmGetSavedItemsOrderBySku.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItemsOrderBySku.defaultExpectation.params)
 		}
 	}
 
-	return mmUpsertCartItem
+	return mmGetSavedItemsOrderBySku
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CartRepository.UpsertCartItem
-func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockUpsertCartItem {
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.GetSavedItemsOrderBySku
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockGetSavedItemsOrderBySku {
+	if mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Set")
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation = &CartRepositoryMockGetSavedItemsOrderBySkuExpectation{}
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation.params != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Expect")
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs = &CartRepositoryMockGetSavedItemsOrderBySkuParamPtrs{}
+	}
+	mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedItemsOrderBySku.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedItemsOrderBySku
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.GetSavedItemsOrderBySku
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) ExpectUserIDParam2(userID int64) *mCartRepositoryMockGetSavedItemsOrderBySku {
+	if mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Set")
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation = &CartRepositoryMockGetSavedItemsOrderBySkuExpectation{}
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation.params != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Expect")
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs = &CartRepositoryMockGetSavedItemsOrderBySkuParamPtrs{}
+	}
+	mmGetSavedItemsOrderBySku.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSavedItemsOrderBySku.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSavedItemsOrderBySku
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.GetSavedItemsOrderBySku
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Inspect(f func(ctx context.Context, userID int64)) *mCartRepositoryMockGetSavedItemsOrderBySku {
+	if mmGetSavedItemsOrderBySku.mock.inspectFuncGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.GetSavedItemsOrderBySku")
+	}
+
+	mmGetSavedItemsOrderBySku.mock.inspectFuncGetSavedItemsOrderBySku = f
+
+	return mmGetSavedItemsOrderBySku
+}
+
+// Return sets up results that will be returned by CartRepository.GetSavedItemsOrderBySku
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Return(cpa1 []*domain.CartItem, err error) *CartRepositoryMock {
+	if mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Set")
+	}
+
+	if mmGetSavedItemsOrderBySku.defaultExpectation == nil {
+		mmGetSavedItemsOrderBySku.defaultExpectation = &CartRepositoryMockGetSavedItemsOrderBySkuExpectation{mock: mmGetSavedItemsOrderBySku.mock}
+	}
+	mmGetSavedItemsOrderBySku.defaultExpectation.results = &CartRepositoryMockGetSavedItemsOrderBySkuResults{cpa1, err}
+	mmGetSavedItemsOrderBySku.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItemsOrderBySku.mock
+}
+
+// Set uses given function f to mock the CartRepository.GetSavedItemsOrderBySku method
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Set(f func(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error)) *CartRepositoryMock {
+	if mmGetSavedItemsOrderBySku.defaultExpectation != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("Default expectation is already set for the CartRepository.GetSavedItemsOrderBySku method")
+	}
+
+	if len(mmGetSavedItemsOrderBySku.expectations) > 0 {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("Some expectations are already set for the CartRepository.GetSavedItemsOrderBySku method")
+	}
+
+	mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku = f
+	mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySkuOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItemsOrderBySku.mock
+}
+
+// When sets expectation for the CartRepository.GetSavedItemsOrderBySku which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) When(ctx context.Context, userID int64) *CartRepositoryMockGetSavedItemsOrderBySkuExpectation {
+	if mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("CartRepositoryMock.GetSavedItemsOrderBySku mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockGetSavedItemsOrderBySkuExpectation{
+		mock:               mmGetSavedItemsOrderBySku.mock,
+		params:             &CartRepositoryMockGetSavedItemsOrderBySkuParams{ctx, userID},
+		expectationOrigins: CartRepositoryMockGetSavedItemsOrderBySkuExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedItemsOrderBySku.expectations = append(mmGetSavedItemsOrderBySku.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.GetSavedItemsOrderBySku return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockGetSavedItemsOrderBySkuExpectation) Then(cpa1 []*domain.CartItem, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockGetSavedItemsOrderBySkuResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.GetSavedItemsOrderBySku should be invoked
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Times(n uint64) *mCartRepositoryMockGetSavedItemsOrderBySku {
+	if n == 0 {
+		mmGetSavedItemsOrderBySku.mock.t.Fatalf("Times of CartRepositoryMock.GetSavedItemsOrderBySku mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedItemsOrderBySku.expectedInvocations, n)
+	mmGetSavedItemsOrderBySku.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItemsOrderBySku
+}
+
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) invocationsDone() bool {
+	if len(mmGetSavedItemsOrderBySku.expectations) == 0 && mmGetSavedItemsOrderBySku.defaultExpectation == nil && mmGetSavedItemsOrderBySku.mock.funcGetSavedItemsOrderBySku == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedItemsOrderBySku.mock.afterGetSavedItemsOrderBySkuCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedItemsOrderBySku.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedItemsOrderBySku implements mm_service.CartRepository
+func (mmGetSavedItemsOrderBySku *CartRepositoryMock) GetSavedItemsOrderBySku(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItemsOrderBySku.beforeGetSavedItemsOrderBySkuCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItemsOrderBySku.afterGetSavedItemsOrderBySkuCounter, 1)
+
+	mmGetSavedItemsOrderBySku.t.Helper()
+
+	if mmGetSavedItemsOrderBySku.inspectFuncGetSavedItemsOrderBySku != nil {
+		mmGetSavedItemsOrderBySku.inspectFuncGetSavedItemsOrderBySku(ctx, userID)
+	}
+
+	mm_params := CartRepositoryMockGetSavedItemsOrderBySkuParams{ctx, userID}
+
+	// Record call args
+	mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.mutex.Lock()
+	mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.callArgs = append(mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.callArgs, &mm_params)
+	mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockGetSavedItemsOrderBySkuParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedItemsOrderBySku.t.Errorf("CartRepositoryMock.GetSavedItemsOrderBySku got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSavedItemsOrderBySku.t.Errorf("CartRepositoryMock.GetSavedItemsOrderBySku got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItemsOrderBySku.t.Errorf("CartRepositoryMock.GetSavedItemsOrderBySku got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItemsOrderBySku.GetSavedItemsOrderBySkuMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItemsOrderBySku.t.Fatal("No results are set for the CartRepositoryMock.GetSavedItemsOrderBySku")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetSavedItemsOrderBySku.funcGetSavedItemsOrderBySku != nil {
+		return mmGetSavedItemsOrderBySku.funcGetSavedItemsOrderBySku(ctx, userID)
+	}
+	mmGetSavedItemsOrderBySku.t.Fatalf("Unexpected call to CartRepositoryMock.GetSavedItemsOrderBySku. %v %v", ctx, userID)
+	return
+}
+
+// GetSavedItemsOrderBySkuAfterCounter returns a count of finished CartRepositoryMock.GetSavedItemsOrderBySku invocations
+func (mmGetSavedItemsOrderBySku *CartRepositoryMock) GetSavedItemsOrderBySkuAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItemsOrderBySku.afterGetSavedItemsOrderBySkuCounter)
+}
+
+// GetSavedItemsOrderBySkuBeforeCounter returns a count of CartRepositoryMock.GetSavedItemsOrderBySku invocations
+func (mmGetSavedItemsOrderBySku *CartRepositoryMock) GetSavedItemsOrderBySkuBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItemsOrderBySku.beforeGetSavedItemsOrderBySkuCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.GetSavedItemsOrderBySku.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItemsOrderBySku *mCartRepositoryMockGetSavedItemsOrderBySku) Calls() []*CartRepositoryMockGetSavedItemsOrderBySkuParams {
+	mmGetSavedItemsOrderBySku.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockGetSavedItemsOrderBySkuParams, len(mmGetSavedItemsOrderBySku.callArgs))
+	copy(argCopy, mmGetSavedItemsOrderBySku.callArgs)
+
+	mmGetSavedItemsOrderBySku.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemsOrderBySkuDone returns true if the count of the GetSavedItemsOrderBySku invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockGetSavedItemsOrderBySkuDone() bool {
+	if m.GetSavedItemsOrderBySkuMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedItemsOrderBySkuMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedItemsOrderBySkuMock.invocationsDone()
+}
+
+// MinimockGetSavedItemsOrderBySkuInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockGetSavedItemsOrderBySkuInspect() {
+	for _, e := range m.GetSavedItemsOrderBySkuMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetSavedItemsOrderBySku at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedItemsOrderBySkuCounter := mm_atomic.LoadUint64(&m.afterGetSavedItemsOrderBySkuCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsOrderBySkuMock.defaultExpectation != nil && afterGetSavedItemsOrderBySkuCounter < 1 {
+		if m.GetSavedItemsOrderBySkuMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetSavedItemsOrderBySku at\n%s", m.GetSavedItemsOrderBySkuMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.GetSavedItemsOrderBySku at\n%s with params: %#v", m.GetSavedItemsOrderBySkuMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedItemsOrderBySkuMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItemsOrderBySku != nil && afterGetSavedItemsOrderBySkuCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.GetSavedItemsOrderBySku at\n%s", m.funcGetSavedItemsOrderBySkuOrigin)
+	}
+
+	if !m.GetSavedItemsOrderBySkuMock.invocationsDone() && afterGetSavedItemsOrderBySkuCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.GetSavedItemsOrderBySku at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedItemsOrderBySkuMock.expectedInvocations), m.GetSavedItemsOrderBySkuMock.expectedInvocationsOrigin, afterGetSavedItemsOrderBySkuCounter)
+	}
+}
+
+type mCartRepositoryMockMergeCarts struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockMergeCartsExpectation
+	expectations       []*CartRepositoryMockMergeCartsExpectation
+
+	callArgs []*CartRepositoryMockMergeCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockMergeCartsExpectation specifies expectation struct of the CartRepository.MergeCarts
+type CartRepositoryMockMergeCartsExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockMergeCartsParams
+	paramPtrs          *CartRepositoryMockMergeCartsParamPtrs
+	expectationOrigins CartRepositoryMockMergeCartsExpectationOrigins
+	results            *CartRepositoryMockMergeCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockMergeCartsParams contains parameters of the CartRepository.MergeCarts
+type CartRepositoryMockMergeCartsParams struct {
+	ctx        context.Context
+	userID     int64
+	fromCartID int64
+	policy     domain.MergePolicy
+}
+
+// CartRepositoryMockMergeCartsParamPtrs contains pointers to parameters of the CartRepository.MergeCarts
+type CartRepositoryMockMergeCartsParamPtrs struct {
+	ctx        *context.Context
+	userID     *int64
+	fromCartID *int64
+	policy     *domain.MergePolicy
+}
+
+// CartRepositoryMockMergeCartsResults contains results of the CartRepository.MergeCarts
+type CartRepositoryMockMergeCartsResults struct {
+	err error
+}
+
+// CartRepositoryMockMergeCartsOrigins contains origins of expectations of the CartRepository.MergeCarts
+type CartRepositoryMockMergeCartsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originFromCartID string
+	originPolicy     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Optional() *mCartRepositoryMockMergeCarts {
+	mmMergeCarts.optional = true
+	return mmMergeCarts
+}
+
+// Expect sets up expected params for CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Expect(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by ExpectParams functions")
+	}
+
+	mmMergeCarts.defaultExpectation.params = &CartRepositoryMockMergeCartsParams{ctx, userID, fromCartID, policy}
+	mmMergeCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergeCarts.expectations {
+		if minimock.Equal(e.params, mmMergeCarts.defaultExpectation.params) {
+			mmMergeCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergeCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) ExpectUserIDParam2(userID int64) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.userID = &userID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectFromCartIDParam3 sets up expected param fromCartID for CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) ExpectFromCartIDParam3(fromCartID int64) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.fromCartID = &fromCartID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originFromCartID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectPolicyParam4 sets up expected param policy for CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) ExpectPolicyParam4(policy domain.MergePolicy) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartRepositoryMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.policy = &policy
+	mmMergeCarts.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Inspect(f func(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy)) *mCartRepositoryMockMergeCarts {
+	if mmMergeCarts.mock.inspectFuncMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.MergeCarts")
+	}
+
+	mmMergeCarts.mock.inspectFuncMergeCarts = f
+
+	return mmMergeCarts
+}
+
+// Return sets up results that will be returned by CartRepository.MergeCarts
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Return(err error) *CartRepositoryMock {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartRepositoryMockMergeCartsExpectation{mock: mmMergeCarts.mock}
+	}
+	mmMergeCarts.defaultExpectation.results = &CartRepositoryMockMergeCartsResults{err}
+	mmMergeCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// Set uses given function f to mock the CartRepository.MergeCarts method
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Set(f func(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy) (err error)) *CartRepositoryMock {
+	if mmMergeCarts.defaultExpectation != nil {
+		mmMergeCarts.mock.t.Fatalf("Default expectation is already set for the CartRepository.MergeCarts method")
+	}
+
+	if len(mmMergeCarts.expectations) > 0 {
+		mmMergeCarts.mock.t.Fatalf("Some expectations are already set for the CartRepository.MergeCarts method")
+	}
+
+	mmMergeCarts.mock.funcMergeCarts = f
+	mmMergeCarts.mock.funcMergeCartsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// When sets expectation for the CartRepository.MergeCarts which will trigger the result defined by the following
+// Then helper
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) When(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy) *CartRepositoryMockMergeCartsExpectation {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartRepositoryMock.MergeCarts mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockMergeCartsExpectation{
+		mock:               mmMergeCarts.mock,
+		params:             &CartRepositoryMockMergeCartsParams{ctx, userID, fromCartID, policy},
+		expectationOrigins: CartRepositoryMockMergeCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergeCarts.expectations = append(mmMergeCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.MergeCarts return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockMergeCartsExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockMergeCartsResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.MergeCarts should be invoked
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Times(n uint64) *mCartRepositoryMockMergeCarts {
+	if n == 0 {
+		mmMergeCarts.mock.t.Fatalf("Times of CartRepositoryMock.MergeCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergeCarts.expectedInvocations, n)
+	mmMergeCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts
+}
+
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) invocationsDone() bool {
+	if len(mmMergeCarts.expectations) == 0 && mmMergeCarts.defaultExpectation == nil && mmMergeCarts.mock.funcMergeCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergeCarts.mock.afterMergeCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergeCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergeCarts implements mm_service.CartRepository
+func (mmMergeCarts *CartRepositoryMock) MergeCarts(ctx context.Context, userID int64, fromCartID int64, policy domain.MergePolicy) (err error) {
+	mm_atomic.AddUint64(&mmMergeCarts.beforeMergeCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCarts.afterMergeCartsCounter, 1)
+
+	mmMergeCarts.t.Helper()
+
+	if mmMergeCarts.inspectFuncMergeCarts != nil {
+		mmMergeCarts.inspectFuncMergeCarts(ctx, userID, fromCartID, policy)
+	}
+
+	mm_params := CartRepositoryMockMergeCartsParams{ctx, userID, fromCartID, policy}
+
+	// Record call args
+	mmMergeCarts.MergeCartsMock.mutex.Lock()
+	mmMergeCarts.MergeCartsMock.callArgs = append(mmMergeCarts.MergeCartsMock.callArgs, &mm_params)
+	mmMergeCarts.MergeCartsMock.mutex.Unlock()
+
+	for _, e := range mmMergeCarts.MergeCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMergeCarts.MergeCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCarts.MergeCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCarts.MergeCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmMergeCarts.MergeCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockMergeCartsParams{ctx, userID, fromCartID, policy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergeCarts.t.Errorf("CartRepositoryMock.MergeCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMergeCarts.t.Errorf("CartRepositoryMock.MergeCarts got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.fromCartID != nil && !minimock.Equal(*mm_want_ptrs.fromCartID, mm_got.fromCartID) {
+				mmMergeCarts.t.Errorf("CartRepositoryMock.MergeCarts got unexpected parameter fromCartID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originFromCartID, *mm_want_ptrs.fromCartID, mm_got.fromCartID, minimock.Diff(*mm_want_ptrs.fromCartID, mm_got.fromCartID))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmMergeCarts.t.Errorf("CartRepositoryMock.MergeCarts got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCarts.t.Errorf("CartRepositoryMock.MergeCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCarts.MergeCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCarts.t.Fatal("No results are set for the CartRepositoryMock.MergeCarts")
+		}
+		return (*mm_results).err
+	}
+	if mmMergeCarts.funcMergeCarts != nil {
+		return mmMergeCarts.funcMergeCarts(ctx, userID, fromCartID, policy)
+	}
+	mmMergeCarts.t.Fatalf("Unexpected call to CartRepositoryMock.MergeCarts. %v %v %v %v", ctx, userID, fromCartID, policy)
+	return
+}
+
+// MergeCartsAfterCounter returns a count of finished CartRepositoryMock.MergeCarts invocations
+func (mmMergeCarts *CartRepositoryMock) MergeCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.afterMergeCartsCounter)
+}
+
+// MergeCartsBeforeCounter returns a count of CartRepositoryMock.MergeCarts invocations
+func (mmMergeCarts *CartRepositoryMock) MergeCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.beforeMergeCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.MergeCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCarts *mCartRepositoryMockMergeCarts) Calls() []*CartRepositoryMockMergeCartsParams {
+	mmMergeCarts.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockMergeCartsParams, len(mmMergeCarts.callArgs))
+	copy(argCopy, mmMergeCarts.callArgs)
+
+	mmMergeCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartsDone returns true if the count of the MergeCarts invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockMergeCartsDone() bool {
+	if m.MergeCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeCartsMock.invocationsDone()
+}
+
+// MinimockMergeCartsInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockMergeCartsInspect() {
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCartsCounter := mm_atomic.LoadUint64(&m.afterMergeCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartsMock.defaultExpectation != nil && afterMergeCartsCounter < 1 {
+		if m.MergeCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCarts at\n%s", m.MergeCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.MergeCarts at\n%s with params: %#v", m.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *m.MergeCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCarts != nil && afterMergeCartsCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.MergeCarts at\n%s", m.funcMergeCartsOrigin)
+	}
+
+	if !m.MergeCartsMock.invocationsDone() && afterMergeCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.MergeCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeCartsMock.expectedInvocations), m.MergeCartsMock.expectedInvocationsOrigin, afterMergeCartsCounter)
+	}
+}
+
+type mCartRepositoryMockMoveCartItemToSaved struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockMoveCartItemToSavedExpectation
+	expectations       []*CartRepositoryMockMoveCartItemToSavedExpectation
+
+	callArgs []*CartRepositoryMockMoveCartItemToSavedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockMoveCartItemToSavedExpectation specifies expectation struct of the CartRepository.MoveCartItemToSaved
+type CartRepositoryMockMoveCartItemToSavedExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockMoveCartItemToSavedParams
+	paramPtrs          *CartRepositoryMockMoveCartItemToSavedParamPtrs
+	expectationOrigins CartRepositoryMockMoveCartItemToSavedExpectationOrigins
+	results            *CartRepositoryMockMoveCartItemToSavedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockMoveCartItemToSavedParams contains parameters of the CartRepository.MoveCartItemToSaved
+type CartRepositoryMockMoveCartItemToSavedParams struct {
+	ctx    context.Context
+	userID int64
+	skuID  int64
+}
+
+// CartRepositoryMockMoveCartItemToSavedParamPtrs contains pointers to parameters of the CartRepository.MoveCartItemToSaved
+type CartRepositoryMockMoveCartItemToSavedParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	skuID  *int64
+}
+
+// CartRepositoryMockMoveCartItemToSavedResults contains results of the CartRepository.MoveCartItemToSaved
+type CartRepositoryMockMoveCartItemToSavedResults struct {
+	cp1 *domain.CartItem
+	err error
+}
+
+// CartRepositoryMockMoveCartItemToSavedOrigins contains origins of expectations of the CartRepository.MoveCartItemToSaved
+type CartRepositoryMockMoveCartItemToSavedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Optional() *mCartRepositoryMockMoveCartItemToSaved {
+	mmMoveCartItemToSaved.optional = true
+	return mmMoveCartItemToSaved
+}
+
+// Expect sets up expected params for CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Expect(ctx context.Context, userID int64, skuID int64) *mCartRepositoryMockMoveCartItemToSaved {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation == nil {
+		mmMoveCartItemToSaved.defaultExpectation = &CartRepositoryMockMoveCartItemToSavedExpectation{}
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.paramPtrs != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by ExpectParams functions")
+	}
+
+	mmMoveCartItemToSaved.defaultExpectation.params = &CartRepositoryMockMoveCartItemToSavedParams{ctx, userID, skuID}
+	mmMoveCartItemToSaved.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveCartItemToSaved.expectations {
+		if minimock.Equal(e.params, mmMoveCartItemToSaved.defaultExpectation.params) {
+			mmMoveCartItemToSaved.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveCartItemToSaved.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveCartItemToSaved
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockMoveCartItemToSaved {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation == nil {
+		mmMoveCartItemToSaved.defaultExpectation = &CartRepositoryMockMoveCartItemToSavedExpectation{}
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.params != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Expect")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveCartItemToSaved.defaultExpectation.paramPtrs = &CartRepositoryMockMoveCartItemToSavedParamPtrs{}
+	}
+	mmMoveCartItemToSaved.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveCartItemToSaved.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveCartItemToSaved
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) ExpectUserIDParam2(userID int64) *mCartRepositoryMockMoveCartItemToSaved {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation == nil {
+		mmMoveCartItemToSaved.defaultExpectation = &CartRepositoryMockMoveCartItemToSavedExpectation{}
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.params != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Expect")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveCartItemToSaved.defaultExpectation.paramPtrs = &CartRepositoryMockMoveCartItemToSavedParamPtrs{}
+	}
+	mmMoveCartItemToSaved.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveCartItemToSaved.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveCartItemToSaved
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) ExpectSkuIDParam3(skuID int64) *mCartRepositoryMockMoveCartItemToSaved {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation == nil {
+		mmMoveCartItemToSaved.defaultExpectation = &CartRepositoryMockMoveCartItemToSavedExpectation{}
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.params != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Expect")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation.paramPtrs == nil {
+		mmMoveCartItemToSaved.defaultExpectation.paramPtrs = &CartRepositoryMockMoveCartItemToSavedParamPtrs{}
+	}
+	mmMoveCartItemToSaved.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveCartItemToSaved.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveCartItemToSaved
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mCartRepositoryMockMoveCartItemToSaved {
+	if mmMoveCartItemToSaved.mock.inspectFuncMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.MoveCartItemToSaved")
+	}
+
+	mmMoveCartItemToSaved.mock.inspectFuncMoveCartItemToSaved = f
+
+	return mmMoveCartItemToSaved
+}
+
+// Return sets up results that will be returned by CartRepository.MoveCartItemToSaved
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Return(cp1 *domain.CartItem, err error) *CartRepositoryMock {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	if mmMoveCartItemToSaved.defaultExpectation == nil {
+		mmMoveCartItemToSaved.defaultExpectation = &CartRepositoryMockMoveCartItemToSavedExpectation{mock: mmMoveCartItemToSaved.mock}
+	}
+	mmMoveCartItemToSaved.defaultExpectation.results = &CartRepositoryMockMoveCartItemToSavedResults{cp1, err}
+	mmMoveCartItemToSaved.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveCartItemToSaved.mock
+}
+
+// Set uses given function f to mock the CartRepository.MoveCartItemToSaved method
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Set(f func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)) *CartRepositoryMock {
+	if mmMoveCartItemToSaved.defaultExpectation != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("Default expectation is already set for the CartRepository.MoveCartItemToSaved method")
+	}
+
+	if len(mmMoveCartItemToSaved.expectations) > 0 {
+		mmMoveCartItemToSaved.mock.t.Fatalf("Some expectations are already set for the CartRepository.MoveCartItemToSaved method")
+	}
+
+	mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved = f
+	mmMoveCartItemToSaved.mock.funcMoveCartItemToSavedOrigin = minimock.CallerInfo(1)
+	return mmMoveCartItemToSaved.mock
+}
+
+// When sets expectation for the CartRepository.MoveCartItemToSaved which will trigger the result defined by the following
+// Then helper
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) When(ctx context.Context, userID int64, skuID int64) *CartRepositoryMockMoveCartItemToSavedExpectation {
+	if mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.mock.t.Fatalf("CartRepositoryMock.MoveCartItemToSaved mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockMoveCartItemToSavedExpectation{
+		mock:               mmMoveCartItemToSaved.mock,
+		params:             &CartRepositoryMockMoveCartItemToSavedParams{ctx, userID, skuID},
+		expectationOrigins: CartRepositoryMockMoveCartItemToSavedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveCartItemToSaved.expectations = append(mmMoveCartItemToSaved.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.MoveCartItemToSaved return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockMoveCartItemToSavedExpectation) Then(cp1 *domain.CartItem, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockMoveCartItemToSavedResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.MoveCartItemToSaved should be invoked
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Times(n uint64) *mCartRepositoryMockMoveCartItemToSaved {
+	if n == 0 {
+		mmMoveCartItemToSaved.mock.t.Fatalf("Times of CartRepositoryMock.MoveCartItemToSaved mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveCartItemToSaved.expectedInvocations, n)
+	mmMoveCartItemToSaved.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveCartItemToSaved
+}
+
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) invocationsDone() bool {
+	if len(mmMoveCartItemToSaved.expectations) == 0 && mmMoveCartItemToSaved.defaultExpectation == nil && mmMoveCartItemToSaved.mock.funcMoveCartItemToSaved == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveCartItemToSaved.mock.afterMoveCartItemToSavedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveCartItemToSaved.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveCartItemToSaved implements mm_service.CartRepository
+func (mmMoveCartItemToSaved *CartRepositoryMock) MoveCartItemToSaved(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmMoveCartItemToSaved.beforeMoveCartItemToSavedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveCartItemToSaved.afterMoveCartItemToSavedCounter, 1)
+
+	mmMoveCartItemToSaved.t.Helper()
+
+	if mmMoveCartItemToSaved.inspectFuncMoveCartItemToSaved != nil {
+		mmMoveCartItemToSaved.inspectFuncMoveCartItemToSaved(ctx, userID, skuID)
+	}
+
+	mm_params := CartRepositoryMockMoveCartItemToSavedParams{ctx, userID, skuID}
+
+	// Record call args
+	mmMoveCartItemToSaved.MoveCartItemToSavedMock.mutex.Lock()
+	mmMoveCartItemToSaved.MoveCartItemToSavedMock.callArgs = append(mmMoveCartItemToSaved.MoveCartItemToSavedMock.callArgs, &mm_params)
+	mmMoveCartItemToSaved.MoveCartItemToSavedMock.mutex.Unlock()
+
+	for _, e := range mmMoveCartItemToSaved.MoveCartItemToSavedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockMoveCartItemToSavedParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveCartItemToSaved.t.Errorf("CartRepositoryMock.MoveCartItemToSaved got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveCartItemToSaved.t.Errorf("CartRepositoryMock.MoveCartItemToSaved got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveCartItemToSaved.t.Errorf("CartRepositoryMock.MoveCartItemToSaved got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveCartItemToSaved.t.Errorf("CartRepositoryMock.MoveCartItemToSaved got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveCartItemToSaved.MoveCartItemToSavedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveCartItemToSaved.t.Fatal("No results are set for the CartRepositoryMock.MoveCartItemToSaved")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmMoveCartItemToSaved.funcMoveCartItemToSaved != nil {
+		return mmMoveCartItemToSaved.funcMoveCartItemToSaved(ctx, userID, skuID)
+	}
+	mmMoveCartItemToSaved.t.Fatalf("Unexpected call to CartRepositoryMock.MoveCartItemToSaved. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// MoveCartItemToSavedAfterCounter returns a count of finished CartRepositoryMock.MoveCartItemToSaved invocations
+func (mmMoveCartItemToSaved *CartRepositoryMock) MoveCartItemToSavedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveCartItemToSaved.afterMoveCartItemToSavedCounter)
+}
+
+// MoveCartItemToSavedBeforeCounter returns a count of CartRepositoryMock.MoveCartItemToSaved invocations
+func (mmMoveCartItemToSaved *CartRepositoryMock) MoveCartItemToSavedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveCartItemToSaved.beforeMoveCartItemToSavedCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.MoveCartItemToSaved.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveCartItemToSaved *mCartRepositoryMockMoveCartItemToSaved) Calls() []*CartRepositoryMockMoveCartItemToSavedParams {
+	mmMoveCartItemToSaved.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockMoveCartItemToSavedParams, len(mmMoveCartItemToSaved.callArgs))
+	copy(argCopy, mmMoveCartItemToSaved.callArgs)
+
+	mmMoveCartItemToSaved.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveCartItemToSavedDone returns true if the count of the MoveCartItemToSaved invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockMoveCartItemToSavedDone() bool {
+	if m.MoveCartItemToSavedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveCartItemToSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveCartItemToSavedMock.invocationsDone()
+}
+
+// MinimockMoveCartItemToSavedInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockMoveCartItemToSavedInspect() {
+	for _, e := range m.MoveCartItemToSavedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveCartItemToSaved at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveCartItemToSavedCounter := mm_atomic.LoadUint64(&m.afterMoveCartItemToSavedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveCartItemToSavedMock.defaultExpectation != nil && afterMoveCartItemToSavedCounter < 1 {
+		if m.MoveCartItemToSavedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveCartItemToSaved at\n%s", m.MoveCartItemToSavedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveCartItemToSaved at\n%s with params: %#v", m.MoveCartItemToSavedMock.defaultExpectation.expectationOrigins.origin, *m.MoveCartItemToSavedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveCartItemToSaved != nil && afterMoveCartItemToSavedCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.MoveCartItemToSaved at\n%s", m.funcMoveCartItemToSavedOrigin)
+	}
+
+	if !m.MoveCartItemToSavedMock.invocationsDone() && afterMoveCartItemToSavedCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.MoveCartItemToSaved at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveCartItemToSavedMock.expectedInvocations), m.MoveCartItemToSavedMock.expectedInvocationsOrigin, afterMoveCartItemToSavedCounter)
+	}
+}
+
+type mCartRepositoryMockMoveSavedItemToCart struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockMoveSavedItemToCartExpectation
+	expectations       []*CartRepositoryMockMoveSavedItemToCartExpectation
+
+	callArgs []*CartRepositoryMockMoveSavedItemToCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockMoveSavedItemToCartExpectation specifies expectation struct of the CartRepository.MoveSavedItemToCart
+type CartRepositoryMockMoveSavedItemToCartExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockMoveSavedItemToCartParams
+	paramPtrs          *CartRepositoryMockMoveSavedItemToCartParamPtrs
+	expectationOrigins CartRepositoryMockMoveSavedItemToCartExpectationOrigins
+	results            *CartRepositoryMockMoveSavedItemToCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockMoveSavedItemToCartParams contains parameters of the CartRepository.MoveSavedItemToCart
+type CartRepositoryMockMoveSavedItemToCartParams struct {
+	ctx        context.Context
+	userID     int64
+	skuID      int64
+	addedPrice domain.Money
+}
+
+// CartRepositoryMockMoveSavedItemToCartParamPtrs contains pointers to parameters of the CartRepository.MoveSavedItemToCart
+type CartRepositoryMockMoveSavedItemToCartParamPtrs struct {
+	ctx        *context.Context
+	userID     *int64
+	skuID      *int64
+	addedPrice *domain.Money
+}
+
+// CartRepositoryMockMoveSavedItemToCartResults contains results of the CartRepository.MoveSavedItemToCart
+type CartRepositoryMockMoveSavedItemToCartResults struct {
+	cp1 *domain.CartItem
+	err error
+}
+
+// CartRepositoryMockMoveSavedItemToCartOrigins contains origins of expectations of the CartRepository.MoveSavedItemToCart
+type CartRepositoryMockMoveSavedItemToCartExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUserID     string
+	originSkuID      string
+	originAddedPrice string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Optional() *mCartRepositoryMockMoveSavedItemToCart {
+	mmMoveSavedItemToCart.optional = true
+	return mmMoveSavedItemToCart
+}
+
+// Expect sets up expected params for CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Expect(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by ExpectParams functions")
+	}
+
+	mmMoveSavedItemToCart.defaultExpectation.params = &CartRepositoryMockMoveSavedItemToCartParams{ctx, userID, skuID, addedPrice}
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveSavedItemToCart.expectations {
+		if minimock.Equal(e.params, mmMoveSavedItemToCart.defaultExpectation.params) {
+			mmMoveSavedItemToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveSavedItemToCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartRepositoryMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) ExpectUserIDParam2(userID int64) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartRepositoryMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) ExpectSkuIDParam3(skuID int64) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartRepositoryMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectAddedPriceParam4 sets up expected param addedPrice for CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) ExpectAddedPriceParam4(addedPrice domain.Money) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartRepositoryMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.addedPrice = &addedPrice
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originAddedPrice = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Inspect(f func(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money)) *mCartRepositoryMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.inspectFuncMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.MoveSavedItemToCart")
+	}
+
+	mmMoveSavedItemToCart.mock.inspectFuncMoveSavedItemToCart = f
+
+	return mmMoveSavedItemToCart
+}
+
+// Return sets up results that will be returned by CartRepository.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Return(cp1 *domain.CartItem, err error) *CartRepositoryMock {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartRepositoryMockMoveSavedItemToCartExpectation{mock: mmMoveSavedItemToCart.mock}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.results = &CartRepositoryMockMoveSavedItemToCartResults{cp1, err}
+	mmMoveSavedItemToCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart.mock
+}
+
+// Set uses given function f to mock the CartRepository.MoveSavedItemToCart method
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Set(f func(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money) (cp1 *domain.CartItem, err error)) *CartRepositoryMock {
+	if mmMoveSavedItemToCart.defaultExpectation != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Default expectation is already set for the CartRepository.MoveSavedItemToCart method")
+	}
+
+	if len(mmMoveSavedItemToCart.expectations) > 0 {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Some expectations are already set for the CartRepository.MoveSavedItemToCart method")
+	}
+
+	mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart = f
+	mmMoveSavedItemToCart.mock.funcMoveSavedItemToCartOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart.mock
+}
+
+// When sets expectation for the CartRepository.MoveSavedItemToCart which will trigger the result defined by the following
+// Then helper
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) When(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money) *CartRepositoryMockMoveSavedItemToCartExpectation {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartRepositoryMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockMoveSavedItemToCartExpectation{
+		mock:               mmMoveSavedItemToCart.mock,
+		params:             &CartRepositoryMockMoveSavedItemToCartParams{ctx, userID, skuID, addedPrice},
+		expectationOrigins: CartRepositoryMockMoveSavedItemToCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveSavedItemToCart.expectations = append(mmMoveSavedItemToCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.MoveSavedItemToCart return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockMoveSavedItemToCartExpectation) Then(cp1 *domain.CartItem, err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockMoveSavedItemToCartResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.MoveSavedItemToCart should be invoked
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Times(n uint64) *mCartRepositoryMockMoveSavedItemToCart {
+	if n == 0 {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Times of CartRepositoryMock.MoveSavedItemToCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveSavedItemToCart.expectedInvocations, n)
+	mmMoveSavedItemToCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart
+}
+
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) invocationsDone() bool {
+	if len(mmMoveSavedItemToCart.expectations) == 0 && mmMoveSavedItemToCart.defaultExpectation == nil && mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveSavedItemToCart.mock.afterMoveSavedItemToCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveSavedItemToCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveSavedItemToCart implements mm_service.CartRepository
+func (mmMoveSavedItemToCart *CartRepositoryMock) MoveSavedItemToCart(ctx context.Context, userID int64, skuID int64, addedPrice domain.Money) (cp1 *domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmMoveSavedItemToCart.beforeMoveSavedItemToCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveSavedItemToCart.afterMoveSavedItemToCartCounter, 1)
+
+	mmMoveSavedItemToCart.t.Helper()
+
+	if mmMoveSavedItemToCart.inspectFuncMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.inspectFuncMoveSavedItemToCart(ctx, userID, skuID, addedPrice)
+	}
+
+	mm_params := CartRepositoryMockMoveSavedItemToCartParams{ctx, userID, skuID, addedPrice}
+
+	// Record call args
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.mutex.Lock()
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.callArgs = append(mmMoveSavedItemToCart.MoveSavedItemToCartMock.callArgs, &mm_params)
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.mutex.Unlock()
+
+	for _, e := range mmMoveSavedItemToCart.MoveSavedItemToCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockMoveSavedItemToCartParams{ctx, userID, skuID, addedPrice}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveSavedItemToCart.t.Errorf("CartRepositoryMock.MoveSavedItemToCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveSavedItemToCart.t.Errorf("CartRepositoryMock.MoveSavedItemToCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveSavedItemToCart.t.Errorf("CartRepositoryMock.MoveSavedItemToCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+			if mm_want_ptrs.addedPrice != nil && !minimock.Equal(*mm_want_ptrs.addedPrice, mm_got.addedPrice) {
+				mmMoveSavedItemToCart.t.Errorf("CartRepositoryMock.MoveSavedItemToCart got unexpected parameter addedPrice, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originAddedPrice, *mm_want_ptrs.addedPrice, mm_got.addedPrice, minimock.Diff(*mm_want_ptrs.addedPrice, mm_got.addedPrice))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveSavedItemToCart.t.Errorf("CartRepositoryMock.MoveSavedItemToCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveSavedItemToCart.t.Fatal("No results are set for the CartRepositoryMock.MoveSavedItemToCart")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmMoveSavedItemToCart.funcMoveSavedItemToCart != nil {
+		return mmMoveSavedItemToCart.funcMoveSavedItemToCart(ctx, userID, skuID, addedPrice)
+	}
+	mmMoveSavedItemToCart.t.Fatalf("Unexpected call to CartRepositoryMock.MoveSavedItemToCart. %v %v %v %v", ctx, userID, skuID, addedPrice)
+	return
+}
+
+// MoveSavedItemToCartAfterCounter returns a count of finished CartRepositoryMock.MoveSavedItemToCart invocations
+func (mmMoveSavedItemToCart *CartRepositoryMock) MoveSavedItemToCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveSavedItemToCart.afterMoveSavedItemToCartCounter)
+}
+
+// MoveSavedItemToCartBeforeCounter returns a count of CartRepositoryMock.MoveSavedItemToCart invocations
+func (mmMoveSavedItemToCart *CartRepositoryMock) MoveSavedItemToCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveSavedItemToCart.beforeMoveSavedItemToCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.MoveSavedItemToCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveSavedItemToCart *mCartRepositoryMockMoveSavedItemToCart) Calls() []*CartRepositoryMockMoveSavedItemToCartParams {
+	mmMoveSavedItemToCart.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockMoveSavedItemToCartParams, len(mmMoveSavedItemToCart.callArgs))
+	copy(argCopy, mmMoveSavedItemToCart.callArgs)
+
+	mmMoveSavedItemToCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveSavedItemToCartDone returns true if the count of the MoveSavedItemToCart invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockMoveSavedItemToCartDone() bool {
+	if m.MoveSavedItemToCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveSavedItemToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveSavedItemToCartMock.invocationsDone()
+}
+
+// MinimockMoveSavedItemToCartInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockMoveSavedItemToCartInspect() {
+	for _, e := range m.MoveSavedItemToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveSavedItemToCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveSavedItemToCartCounter := mm_atomic.LoadUint64(&m.afterMoveSavedItemToCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveSavedItemToCartMock.defaultExpectation != nil && afterMoveSavedItemToCartCounter < 1 {
+		if m.MoveSavedItemToCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveSavedItemToCart at\n%s", m.MoveSavedItemToCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.MoveSavedItemToCart at\n%s with params: %#v", m.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.origin, *m.MoveSavedItemToCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveSavedItemToCart != nil && afterMoveSavedItemToCartCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.MoveSavedItemToCart at\n%s", m.funcMoveSavedItemToCartOrigin)
+	}
+
+	if !m.MoveSavedItemToCartMock.invocationsDone() && afterMoveSavedItemToCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.MoveSavedItemToCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveSavedItemToCartMock.expectedInvocations), m.MoveSavedItemToCartMock.expectedInvocationsOrigin, afterMoveSavedItemToCartCounter)
+	}
+}
+
+type mCartRepositoryMockSetPromoCode struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockSetPromoCodeExpectation
+	expectations       []*CartRepositoryMockSetPromoCodeExpectation
+
+	callArgs []*CartRepositoryMockSetPromoCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockSetPromoCodeExpectation specifies expectation struct of the CartRepository.SetPromoCode
+type CartRepositoryMockSetPromoCodeExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockSetPromoCodeParams
+	paramPtrs          *CartRepositoryMockSetPromoCodeParamPtrs
+	expectationOrigins CartRepositoryMockSetPromoCodeExpectationOrigins
+	results            *CartRepositoryMockSetPromoCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockSetPromoCodeParams contains parameters of the CartRepository.SetPromoCode
+type CartRepositoryMockSetPromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+	code   string
+}
+
+// CartRepositoryMockSetPromoCodeParamPtrs contains pointers to parameters of the CartRepository.SetPromoCode
+type CartRepositoryMockSetPromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	code   *string
+}
+
+// CartRepositoryMockSetPromoCodeResults contains results of the CartRepository.SetPromoCode
+type CartRepositoryMockSetPromoCodeResults struct {
+	err error
+}
+
+// CartRepositoryMockSetPromoCodeOrigins contains origins of expectations of the CartRepository.SetPromoCode
+type CartRepositoryMockSetPromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Optional() *mCartRepositoryMockSetPromoCode {
+	mmSetPromoCode.optional = true
+	return mmSetPromoCode
+}
+
+// Expect sets up expected params for CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Expect(ctx context.Context, userID int64, code string) *mCartRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &CartRepositoryMockSetPromoCodeExpectation{}
+	}
+
+	if mmSetPromoCode.defaultExpectation.paramPtrs != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by ExpectParams functions")
+	}
+
+	mmSetPromoCode.defaultExpectation.params = &CartRepositoryMockSetPromoCodeParams{ctx, userID, code}
+	mmSetPromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPromoCode.expectations {
+		if minimock.Equal(e.params, mmSetPromoCode.defaultExpectation.params) {
+			mmSetPromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPromoCode.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPromoCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &CartRepositoryMockSetPromoCodeExpectation{}
+	}
+
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Expect")
+	}
+
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &CartRepositoryMockSetPromoCodeParamPtrs{}
+	}
+	mmSetPromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPromoCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) ExpectUserIDParam2(userID int64) *mCartRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &CartRepositoryMockSetPromoCodeExpectation{}
+	}
+
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Expect")
+	}
+
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &CartRepositoryMockSetPromoCodeParamPtrs{}
+	}
+	mmSetPromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSetPromoCode
+}
+
+// ExpectCodeParam3 sets up expected param code for CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) ExpectCodeParam3(code string) *mCartRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &CartRepositoryMockSetPromoCodeExpectation{}
+	}
+
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Expect")
+	}
+
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &CartRepositoryMockSetPromoCodeParamPtrs{}
+	}
+	mmSetPromoCode.defaultExpectation.paramPtrs.code = &code
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmSetPromoCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Inspect(f func(ctx context.Context, userID int64, code string)) *mCartRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.inspectFuncSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("Inspect function is already set for CartRepositoryMock.SetPromoCode")
+	}
+
+	mmSetPromoCode.mock.inspectFuncSetPromoCode = f
+
+	return mmSetPromoCode
+}
+
+// Return sets up results that will be returned by CartRepository.SetPromoCode
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Return(err error) *CartRepositoryMock {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &CartRepositoryMockSetPromoCodeExpectation{mock: mmSetPromoCode.mock}
+	}
+	mmSetPromoCode.defaultExpectation.results = &CartRepositoryMockSetPromoCodeResults{err}
+	mmSetPromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode.mock
+}
+
+// Set uses given function f to mock the CartRepository.SetPromoCode method
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Set(f func(ctx context.Context, userID int64, code string) (err error)) *CartRepositoryMock {
+	if mmSetPromoCode.defaultExpectation != nil {
+		mmSetPromoCode.mock.t.Fatalf("Default expectation is already set for the CartRepository.SetPromoCode method")
+	}
+
+	if len(mmSetPromoCode.expectations) > 0 {
+		mmSetPromoCode.mock.t.Fatalf("Some expectations are already set for the CartRepository.SetPromoCode method")
+	}
+
+	mmSetPromoCode.mock.funcSetPromoCode = f
+	mmSetPromoCode.mock.funcSetPromoCodeOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode.mock
+}
+
+// When sets expectation for the CartRepository.SetPromoCode which will trigger the result defined by the following
+// Then helper
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) When(ctx context.Context, userID int64, code string) *CartRepositoryMockSetPromoCodeExpectation {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("CartRepositoryMock.SetPromoCode mock is already set by Set")
+	}
+
+	expectation := &CartRepositoryMockSetPromoCodeExpectation{
+		mock:               mmSetPromoCode.mock,
+		params:             &CartRepositoryMockSetPromoCodeParams{ctx, userID, code},
+		expectationOrigins: CartRepositoryMockSetPromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPromoCode.expectations = append(mmSetPromoCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartRepository.SetPromoCode return parameters for the expectation previously defined by the When method
+func (e *CartRepositoryMockSetPromoCodeExpectation) Then(err error) *CartRepositoryMock {
+	e.results = &CartRepositoryMockSetPromoCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartRepository.SetPromoCode should be invoked
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Times(n uint64) *mCartRepositoryMockSetPromoCode {
+	if n == 0 {
+		mmSetPromoCode.mock.t.Fatalf("Times of CartRepositoryMock.SetPromoCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPromoCode.expectedInvocations, n)
+	mmSetPromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode
+}
+
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) invocationsDone() bool {
+	if len(mmSetPromoCode.expectations) == 0 && mmSetPromoCode.defaultExpectation == nil && mmSetPromoCode.mock.funcSetPromoCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPromoCode.mock.afterSetPromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPromoCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPromoCode implements mm_service.CartRepository
+func (mmSetPromoCode *CartRepositoryMock) SetPromoCode(ctx context.Context, userID int64, code string) (err error) {
+	mm_atomic.AddUint64(&mmSetPromoCode.beforeSetPromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPromoCode.afterSetPromoCodeCounter, 1)
+
+	mmSetPromoCode.t.Helper()
+
+	if mmSetPromoCode.inspectFuncSetPromoCode != nil {
+		mmSetPromoCode.inspectFuncSetPromoCode(ctx, userID, code)
+	}
+
+	mm_params := CartRepositoryMockSetPromoCodeParams{ctx, userID, code}
+
+	// Record call args
+	mmSetPromoCode.SetPromoCodeMock.mutex.Lock()
+	mmSetPromoCode.SetPromoCodeMock.callArgs = append(mmSetPromoCode.SetPromoCodeMock.callArgs, &mm_params)
+	mmSetPromoCode.SetPromoCodeMock.mutex.Unlock()
+
+	for _, e := range mmSetPromoCode.SetPromoCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPromoCode.SetPromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPromoCode.SetPromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := CartRepositoryMockSetPromoCodeParams{ctx, userID, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPromoCode.t.Errorf("CartRepositoryMock.SetPromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetPromoCode.t.Errorf("CartRepositoryMock.SetPromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmSetPromoCode.t.Errorf("CartRepositoryMock.SetPromoCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPromoCode.t.Errorf("CartRepositoryMock.SetPromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPromoCode.t.Fatal("No results are set for the CartRepositoryMock.SetPromoCode")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPromoCode.funcSetPromoCode != nil {
+		return mmSetPromoCode.funcSetPromoCode(ctx, userID, code)
+	}
+	mmSetPromoCode.t.Fatalf("Unexpected call to CartRepositoryMock.SetPromoCode. %v %v %v", ctx, userID, code)
+	return
+}
+
+// SetPromoCodeAfterCounter returns a count of finished CartRepositoryMock.SetPromoCode invocations
+func (mmSetPromoCode *CartRepositoryMock) SetPromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPromoCode.afterSetPromoCodeCounter)
+}
+
+// SetPromoCodeBeforeCounter returns a count of CartRepositoryMock.SetPromoCode invocations
+func (mmSetPromoCode *CartRepositoryMock) SetPromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPromoCode.beforeSetPromoCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartRepositoryMock.SetPromoCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPromoCode *mCartRepositoryMockSetPromoCode) Calls() []*CartRepositoryMockSetPromoCodeParams {
+	mmSetPromoCode.mutex.RLock()
+
+	argCopy := make([]*CartRepositoryMockSetPromoCodeParams, len(mmSetPromoCode.callArgs))
+	copy(argCopy, mmSetPromoCode.callArgs)
+
+	mmSetPromoCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPromoCodeDone returns true if the count of the SetPromoCode invocations corresponds
+// the number of defined expectations
+func (m *CartRepositoryMock) MinimockSetPromoCodeDone() bool {
+	if m.SetPromoCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPromoCodeMock.invocationsDone()
+}
+
+// MinimockSetPromoCodeInspect logs each unmet expectation
+func (m *CartRepositoryMock) MinimockSetPromoCodeInspect() {
+	for _, e := range m.SetPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartRepositoryMock.SetPromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPromoCodeCounter := mm_atomic.LoadUint64(&m.afterSetPromoCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPromoCodeMock.defaultExpectation != nil && afterSetPromoCodeCounter < 1 {
+		if m.SetPromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartRepositoryMock.SetPromoCode at\n%s", m.SetPromoCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartRepositoryMock.SetPromoCode at\n%s with params: %#v", m.SetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.SetPromoCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPromoCode != nil && afterSetPromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to CartRepositoryMock.SetPromoCode at\n%s", m.funcSetPromoCodeOrigin)
+	}
+
+	if !m.SetPromoCodeMock.invocationsDone() && afterSetPromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartRepositoryMock.SetPromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPromoCodeMock.expectedInvocations), m.SetPromoCodeMock.expectedInvocationsOrigin, afterSetPromoCodeCounter)
+	}
+}
+
+type mCartRepositoryMockUpsertCartItem struct {
+	optional           bool
+	mock               *CartRepositoryMock
+	defaultExpectation *CartRepositoryMockUpsertCartItemExpectation
+	expectations       []*CartRepositoryMockUpsertCartItemExpectation
+
+	callArgs []*CartRepositoryMockUpsertCartItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartRepositoryMockUpsertCartItemExpectation specifies expectation struct of the CartRepository.UpsertCartItem
+type CartRepositoryMockUpsertCartItemExpectation struct {
+	mock               *CartRepositoryMock
+	params             *CartRepositoryMockUpsertCartItemParams
+	paramPtrs          *CartRepositoryMockUpsertCartItemParamPtrs
+	expectationOrigins CartRepositoryMockUpsertCartItemExpectationOrigins
+	results            *CartRepositoryMockUpsertCartItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartRepositoryMockUpsertCartItemParams contains parameters of the CartRepository.UpsertCartItem
+type CartRepositoryMockUpsertCartItemParams struct {
+	ctx     context.Context
+	userID  int64
+	newItem *domain.CartItem
+}
+
+// CartRepositoryMockUpsertCartItemParamPtrs contains pointers to parameters of the CartRepository.UpsertCartItem
+type CartRepositoryMockUpsertCartItemParamPtrs struct {
+	ctx     *context.Context
+	userID  *int64
+	newItem **domain.CartItem
+}
+
+// CartRepositoryMockUpsertCartItemResults contains results of the CartRepository.UpsertCartItem
+type CartRepositoryMockUpsertCartItemResults struct {
+	cp1 *domain.CartItem
+	err error
+}
+
+// CartRepositoryMockUpsertCartItemOrigins contains origins of expectations of the CartRepository.UpsertCartItem
+type CartRepositoryMockUpsertCartItemExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originNewItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) Optional() *mCartRepositoryMockUpsertCartItem {
+	mmUpsertCartItem.optional = true
+	return mmUpsertCartItem
+}
+
+// Expect sets up expected params for CartRepository.UpsertCartItem
+func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) Expect(ctx context.Context, userID int64, newItem *domain.CartItem) *mCartRepositoryMockUpsertCartItem {
+	if mmUpsertCartItem.mock.funcUpsertCartItem != nil {
+		mmUpsertCartItem.mock.t.Fatalf("CartRepositoryMock.UpsertCartItem mock is already set by Set")
+	}
+
+	if mmUpsertCartItem.defaultExpectation == nil {
+		mmUpsertCartItem.defaultExpectation = &CartRepositoryMockUpsertCartItemExpectation{}
+	}
+
+	if mmUpsertCartItem.defaultExpectation.paramPtrs != nil {
+		mmUpsertCartItem.mock.t.Fatalf("CartRepositoryMock.UpsertCartItem mock is already set by ExpectParams functions")
+	}
+
+	mmUpsertCartItem.defaultExpectation.params = &CartRepositoryMockUpsertCartItemParams{ctx, userID, newItem}
+	mmUpsertCartItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpsertCartItem.expectations {
+		if minimock.Equal(e.params, mmUpsertCartItem.defaultExpectation.params) {
+			mmUpsertCartItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpsertCartItem.defaultExpectation.params)
+		}
+	}
+
+	return mmUpsertCartItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartRepository.UpsertCartItem
+func (mmUpsertCartItem *mCartRepositoryMockUpsertCartItem) ExpectCtxParam1(ctx context.Context) *mCartRepositoryMockUpsertCartItem {
 	if mmUpsertCartItem.mock.funcUpsertCartItem != nil {
 		mmUpsertCartItem.mock.t.Fatalf("CartRepositoryMock.UpsertCartItem mock is already set by Set")
 	}
@@ -1513,8 +3845,20 @@ func (m *CartRepositoryMock) MinimockFinish() {
 
 			m.MinimockDeleteCartItemInspect()
 
+			m.MinimockDeleteSavedItemInspect()
+
 			m.MinimockGetCartByUserIDOrderBySkuInspect()
 
+			m.MinimockGetSavedItemsOrderBySkuInspect()
+
+			m.MinimockMergeCartsInspect()
+
+			m.MinimockMoveCartItemToSavedInspect()
+
+			m.MinimockMoveSavedItemToCartInspect()
+
+			m.MinimockSetPromoCodeInspect()
+
 			m.MinimockUpsertCartItemInspect()
 		}
 	})
@@ -1541,6 +3885,12 @@ func (m *CartRepositoryMock) minimockDone() bool {
 	return done &&
 		m.MinimockDeleteCartDone() &&
 		m.MinimockDeleteCartItemDone() &&
+		m.MinimockDeleteSavedItemDone() &&
 		m.MinimockGetCartByUserIDOrderBySkuDone() &&
+		m.MinimockGetSavedItemsOrderBySkuDone() &&
+		m.MinimockMergeCartsDone() &&
+		m.MinimockMoveCartItemToSavedDone() &&
+		m.MinimockMoveSavedItemToCartDone() &&
+		m.MinimockSetPromoCodeDone() &&
 		m.MinimockUpsertCartItemDone()
 }
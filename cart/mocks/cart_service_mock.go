@@ -26,6 +26,13 @@ type CartServiceMock struct {
 	beforeAddCartItemCounter uint64
 	AddCartItemMock          mCartServiceMockAddCartItem
 
+	funcApplyPromoCode          func(ctx context.Context, userID int64, code string) (cp1 *domain.Cart, err error)
+	funcApplyPromoCodeOrigin    string
+	inspectFuncApplyPromoCode   func(ctx context.Context, userID int64, code string)
+	afterApplyPromoCodeCounter  uint64
+	beforeApplyPromoCodeCounter uint64
+	ApplyPromoCodeMock          mCartServiceMockApplyPromoCode
+
 	funcClearCart          func(ctx context.Context, userID int64) (err error)
 	funcClearCartOrigin    string
 	inspectFuncClearCart   func(ctx context.Context, userID int64)
@@ -40,12 +47,54 @@ type CartServiceMock struct {
 	beforeDeleteCartItemCounter uint64
 	DeleteCartItemMock          mCartServiceMockDeleteCartItem
 
+	funcDeleteSavedItem          func(ctx context.Context, userID int64, skuID int64) (err error)
+	funcDeleteSavedItemOrigin    string
+	inspectFuncDeleteSavedItem   func(ctx context.Context, userID int64, skuID int64)
+	afterDeleteSavedItemCounter  uint64
+	beforeDeleteSavedItemCounter uint64
+	DeleteSavedItemMock          mCartServiceMockDeleteSavedItem
+
 	funcGetCart          func(ctx context.Context, userID int64) (cp1 *domain.Cart, err error)
 	funcGetCartOrigin    string
 	inspectFuncGetCart   func(ctx context.Context, userID int64)
 	afterGetCartCounter  uint64
 	beforeGetCartCounter uint64
 	GetCartMock          mCartServiceMockGetCart
+
+	funcGetSavedItems          func(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error)
+	funcGetSavedItemsOrigin    string
+	inspectFuncGetSavedItems   func(ctx context.Context, userID int64)
+	afterGetSavedItemsCounter  uint64
+	beforeGetSavedItemsCounter uint64
+	GetSavedItemsMock          mCartServiceMockGetSavedItems
+
+	funcMergeCarts          func(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy) (cp1 *domain.Cart, err error)
+	funcMergeCartsOrigin    string
+	inspectFuncMergeCarts   func(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy)
+	afterMergeCartsCounter  uint64
+	beforeMergeCartsCounter uint64
+	MergeCartsMock          mCartServiceMockMergeCarts
+
+	funcMoveSavedItemToCart          func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)
+	funcMoveSavedItemToCartOrigin    string
+	inspectFuncMoveSavedItemToCart   func(ctx context.Context, userID int64, skuID int64)
+	afterMoveSavedItemToCartCounter  uint64
+	beforeMoveSavedItemToCartCounter uint64
+	MoveSavedItemToCartMock          mCartServiceMockMoveSavedItemToCart
+
+	funcRemovePromoCode          func(ctx context.Context, userID int64) (err error)
+	funcRemovePromoCodeOrigin    string
+	inspectFuncRemovePromoCode   func(ctx context.Context, userID int64)
+	afterRemovePromoCodeCounter  uint64
+	beforeRemovePromoCodeCounter uint64
+	RemovePromoCodeMock          mCartServiceMockRemovePromoCode
+
+	funcSaveCartItemForLater          func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)
+	funcSaveCartItemForLaterOrigin    string
+	inspectFuncSaveCartItemForLater   func(ctx context.Context, userID int64, skuID int64)
+	afterSaveCartItemForLaterCounter  uint64
+	beforeSaveCartItemForLaterCounter uint64
+	SaveCartItemForLaterMock          mCartServiceMockSaveCartItemForLater
 }
 
 // NewCartServiceMock returns a mock for mm_handler.CartService
@@ -59,15 +108,36 @@ func NewCartServiceMock(t minimock.Tester) *CartServiceMock {
 	m.AddCartItemMock = mCartServiceMockAddCartItem{mock: m}
 	m.AddCartItemMock.callArgs = []*CartServiceMockAddCartItemParams{}
 
+	m.ApplyPromoCodeMock = mCartServiceMockApplyPromoCode{mock: m}
+	m.ApplyPromoCodeMock.callArgs = []*CartServiceMockApplyPromoCodeParams{}
+
 	m.ClearCartMock = mCartServiceMockClearCart{mock: m}
 	m.ClearCartMock.callArgs = []*CartServiceMockClearCartParams{}
 
 	m.DeleteCartItemMock = mCartServiceMockDeleteCartItem{mock: m}
 	m.DeleteCartItemMock.callArgs = []*CartServiceMockDeleteCartItemParams{}
 
+	m.DeleteSavedItemMock = mCartServiceMockDeleteSavedItem{mock: m}
+	m.DeleteSavedItemMock.callArgs = []*CartServiceMockDeleteSavedItemParams{}
+
 	m.GetCartMock = mCartServiceMockGetCart{mock: m}
 	m.GetCartMock.callArgs = []*CartServiceMockGetCartParams{}
 
+	m.GetSavedItemsMock = mCartServiceMockGetSavedItems{mock: m}
+	m.GetSavedItemsMock.callArgs = []*CartServiceMockGetSavedItemsParams{}
+
+	m.MergeCartsMock = mCartServiceMockMergeCarts{mock: m}
+	m.MergeCartsMock.callArgs = []*CartServiceMockMergeCartsParams{}
+
+	m.MoveSavedItemToCartMock = mCartServiceMockMoveSavedItemToCart{mock: m}
+	m.MoveSavedItemToCartMock.callArgs = []*CartServiceMockMoveSavedItemToCartParams{}
+
+	m.RemovePromoCodeMock = mCartServiceMockRemovePromoCode{mock: m}
+	m.RemovePromoCodeMock.callArgs = []*CartServiceMockRemovePromoCodeParams{}
+
+	m.SaveCartItemForLaterMock = mCartServiceMockSaveCartItemForLater{mock: m}
+	m.SaveCartItemForLaterMock.callArgs = []*CartServiceMockSaveCartItemForLaterParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -447,6 +517,380 @@ func (m *CartServiceMock) MinimockAddCartItemInspect() {
 	}
 }
 
+type mCartServiceMockApplyPromoCode struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockApplyPromoCodeExpectation
+	expectations       []*CartServiceMockApplyPromoCodeExpectation
+
+	callArgs []*CartServiceMockApplyPromoCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockApplyPromoCodeExpectation specifies expectation struct of the CartService.ApplyPromoCode
+type CartServiceMockApplyPromoCodeExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockApplyPromoCodeParams
+	paramPtrs          *CartServiceMockApplyPromoCodeParamPtrs
+	expectationOrigins CartServiceMockApplyPromoCodeExpectationOrigins
+	results            *CartServiceMockApplyPromoCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockApplyPromoCodeParams contains parameters of the CartService.ApplyPromoCode
+type CartServiceMockApplyPromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+	code   string
+}
+
+// CartServiceMockApplyPromoCodeParamPtrs contains pointers to parameters of the CartService.ApplyPromoCode
+type CartServiceMockApplyPromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	code   *string
+}
+
+// CartServiceMockApplyPromoCodeResults contains results of the CartService.ApplyPromoCode
+type CartServiceMockApplyPromoCodeResults struct {
+	cp1 *domain.Cart
+	err error
+}
+
+// CartServiceMockApplyPromoCodeOrigins contains origins of expectations of the CartService.ApplyPromoCode
+type CartServiceMockApplyPromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Optional() *mCartServiceMockApplyPromoCode {
+	mmApplyPromoCode.optional = true
+	return mmApplyPromoCode
+}
+
+// Expect sets up expected params for CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Expect(ctx context.Context, userID int64, code string) *mCartServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &CartServiceMockApplyPromoCodeExpectation{}
+	}
+
+	if mmApplyPromoCode.defaultExpectation.paramPtrs != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by ExpectParams functions")
+	}
+
+	mmApplyPromoCode.defaultExpectation.params = &CartServiceMockApplyPromoCodeParams{ctx, userID, code}
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPromoCode.expectations {
+		if minimock.Equal(e.params, mmApplyPromoCode.defaultExpectation.params) {
+			mmApplyPromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPromoCode.defaultExpectation.params)
+		}
+	}
+
+	return mmApplyPromoCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) ExpectCtxParam1(ctx context.Context) *mCartServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &CartServiceMockApplyPromoCodeExpectation{}
+	}
+
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Expect")
+	}
+
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &CartServiceMockApplyPromoCodeParamPtrs{}
+	}
+	mmApplyPromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApplyPromoCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) ExpectUserIDParam2(userID int64) *mCartServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &CartServiceMockApplyPromoCodeExpectation{}
+	}
+
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Expect")
+	}
+
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &CartServiceMockApplyPromoCodeParamPtrs{}
+	}
+	mmApplyPromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmApplyPromoCode
+}
+
+// ExpectCodeParam3 sets up expected param code for CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) ExpectCodeParam3(code string) *mCartServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &CartServiceMockApplyPromoCodeExpectation{}
+	}
+
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Expect")
+	}
+
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &CartServiceMockApplyPromoCodeParamPtrs{}
+	}
+	mmApplyPromoCode.defaultExpectation.paramPtrs.code = &code
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmApplyPromoCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Inspect(f func(ctx context.Context, userID int64, code string)) *mCartServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.inspectFuncApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("Inspect function is already set for CartServiceMock.ApplyPromoCode")
+	}
+
+	mmApplyPromoCode.mock.inspectFuncApplyPromoCode = f
+
+	return mmApplyPromoCode
+}
+
+// Return sets up results that will be returned by CartService.ApplyPromoCode
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Return(cp1 *domain.Cart, err error) *CartServiceMock {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &CartServiceMockApplyPromoCodeExpectation{mock: mmApplyPromoCode.mock}
+	}
+	mmApplyPromoCode.defaultExpectation.results = &CartServiceMockApplyPromoCodeResults{cp1, err}
+	mmApplyPromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode.mock
+}
+
+// Set uses given function f to mock the CartService.ApplyPromoCode method
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Set(f func(ctx context.Context, userID int64, code string) (cp1 *domain.Cart, err error)) *CartServiceMock {
+	if mmApplyPromoCode.defaultExpectation != nil {
+		mmApplyPromoCode.mock.t.Fatalf("Default expectation is already set for the CartService.ApplyPromoCode method")
+	}
+
+	if len(mmApplyPromoCode.expectations) > 0 {
+		mmApplyPromoCode.mock.t.Fatalf("Some expectations are already set for the CartService.ApplyPromoCode method")
+	}
+
+	mmApplyPromoCode.mock.funcApplyPromoCode = f
+	mmApplyPromoCode.mock.funcApplyPromoCodeOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode.mock
+}
+
+// When sets expectation for the CartService.ApplyPromoCode which will trigger the result defined by the following
+// Then helper
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) When(ctx context.Context, userID int64, code string) *CartServiceMockApplyPromoCodeExpectation {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("CartServiceMock.ApplyPromoCode mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockApplyPromoCodeExpectation{
+		mock:               mmApplyPromoCode.mock,
+		params:             &CartServiceMockApplyPromoCodeParams{ctx, userID, code},
+		expectationOrigins: CartServiceMockApplyPromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApplyPromoCode.expectations = append(mmApplyPromoCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.ApplyPromoCode return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockApplyPromoCodeExpectation) Then(cp1 *domain.Cart, err error) *CartServiceMock {
+	e.results = &CartServiceMockApplyPromoCodeResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartService.ApplyPromoCode should be invoked
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Times(n uint64) *mCartServiceMockApplyPromoCode {
+	if n == 0 {
+		mmApplyPromoCode.mock.t.Fatalf("Times of CartServiceMock.ApplyPromoCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApplyPromoCode.expectedInvocations, n)
+	mmApplyPromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode
+}
+
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) invocationsDone() bool {
+	if len(mmApplyPromoCode.expectations) == 0 && mmApplyPromoCode.defaultExpectation == nil && mmApplyPromoCode.mock.funcApplyPromoCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPromoCode.mock.afterApplyPromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPromoCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ApplyPromoCode implements mm_handler.CartService
+func (mmApplyPromoCode *CartServiceMock) ApplyPromoCode(ctx context.Context, userID int64, code string) (cp1 *domain.Cart, err error) {
+	mm_atomic.AddUint64(&mmApplyPromoCode.beforeApplyPromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPromoCode.afterApplyPromoCodeCounter, 1)
+
+	mmApplyPromoCode.t.Helper()
+
+	if mmApplyPromoCode.inspectFuncApplyPromoCode != nil {
+		mmApplyPromoCode.inspectFuncApplyPromoCode(ctx, userID, code)
+	}
+
+	mm_params := CartServiceMockApplyPromoCodeParams{ctx, userID, code}
+
+	// Record call args
+	mmApplyPromoCode.ApplyPromoCodeMock.mutex.Lock()
+	mmApplyPromoCode.ApplyPromoCodeMock.callArgs = append(mmApplyPromoCode.ApplyPromoCodeMock.callArgs, &mm_params)
+	mmApplyPromoCode.ApplyPromoCodeMock.mutex.Unlock()
+
+	for _, e := range mmApplyPromoCode.ApplyPromoCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockApplyPromoCodeParams{ctx, userID, code}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApplyPromoCode.t.Errorf("CartServiceMock.ApplyPromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmApplyPromoCode.t.Errorf("CartServiceMock.ApplyPromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmApplyPromoCode.t.Errorf("CartServiceMock.ApplyPromoCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApplyPromoCode.t.Errorf("CartServiceMock.ApplyPromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApplyPromoCode.t.Fatal("No results are set for the CartServiceMock.ApplyPromoCode")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmApplyPromoCode.funcApplyPromoCode != nil {
+		return mmApplyPromoCode.funcApplyPromoCode(ctx, userID, code)
+	}
+	mmApplyPromoCode.t.Fatalf("Unexpected call to CartServiceMock.ApplyPromoCode. %v %v %v", ctx, userID, code)
+	return
+}
+
+// ApplyPromoCodeAfterCounter returns a count of finished CartServiceMock.ApplyPromoCode invocations
+func (mmApplyPromoCode *CartServiceMock) ApplyPromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromoCode.afterApplyPromoCodeCounter)
+}
+
+// ApplyPromoCodeBeforeCounter returns a count of CartServiceMock.ApplyPromoCode invocations
+func (mmApplyPromoCode *CartServiceMock) ApplyPromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromoCode.beforeApplyPromoCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.ApplyPromoCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApplyPromoCode *mCartServiceMockApplyPromoCode) Calls() []*CartServiceMockApplyPromoCodeParams {
+	mmApplyPromoCode.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockApplyPromoCodeParams, len(mmApplyPromoCode.callArgs))
+	copy(argCopy, mmApplyPromoCode.callArgs)
+
+	mmApplyPromoCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyPromoCodeDone returns true if the count of the ApplyPromoCode invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockApplyPromoCodeDone() bool {
+	if m.ApplyPromoCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyPromoCodeMock.invocationsDone()
+}
+
+// MinimockApplyPromoCodeInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockApplyPromoCodeInspect() {
+	for _, e := range m.ApplyPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.ApplyPromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyPromoCodeCounter := mm_atomic.LoadUint64(&m.afterApplyPromoCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyPromoCodeMock.defaultExpectation != nil && afterApplyPromoCodeCounter < 1 {
+		if m.ApplyPromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.ApplyPromoCode at\n%s", m.ApplyPromoCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.ApplyPromoCode at\n%s with params: %#v", m.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPromoCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApplyPromoCode != nil && afterApplyPromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.ApplyPromoCode at\n%s", m.funcApplyPromoCodeOrigin)
+	}
+
+	if !m.ApplyPromoCodeMock.invocationsDone() && afterApplyPromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.ApplyPromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPromoCodeMock.expectedInvocations), m.ApplyPromoCodeMock.expectedInvocationsOrigin, afterApplyPromoCodeCounter)
+	}
+}
+
 type mCartServiceMockClearCart struct {
 	optional           bool
 	mock               *CartServiceMock
@@ -1162,53 +1606,55 @@ func (m *CartServiceMock) MinimockDeleteCartItemInspect() {
 	}
 }
 
-type mCartServiceMockGetCart struct {
+type mCartServiceMockDeleteSavedItem struct {
 	optional           bool
 	mock               *CartServiceMock
-	defaultExpectation *CartServiceMockGetCartExpectation
-	expectations       []*CartServiceMockGetCartExpectation
+	defaultExpectation *CartServiceMockDeleteSavedItemExpectation
+	expectations       []*CartServiceMockDeleteSavedItemExpectation
 
-	callArgs []*CartServiceMockGetCartParams
+	callArgs []*CartServiceMockDeleteSavedItemParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// CartServiceMockGetCartExpectation specifies expectation struct of the CartService.GetCart
-type CartServiceMockGetCartExpectation struct {
+// CartServiceMockDeleteSavedItemExpectation specifies expectation struct of the CartService.DeleteSavedItem
+type CartServiceMockDeleteSavedItemExpectation struct {
 	mock               *CartServiceMock
-	params             *CartServiceMockGetCartParams
-	paramPtrs          *CartServiceMockGetCartParamPtrs
-	expectationOrigins CartServiceMockGetCartExpectationOrigins
-	results            *CartServiceMockGetCartResults
+	params             *CartServiceMockDeleteSavedItemParams
+	paramPtrs          *CartServiceMockDeleteSavedItemParamPtrs
+	expectationOrigins CartServiceMockDeleteSavedItemExpectationOrigins
+	results            *CartServiceMockDeleteSavedItemResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// CartServiceMockGetCartParams contains parameters of the CartService.GetCart
-type CartServiceMockGetCartParams struct {
+// CartServiceMockDeleteSavedItemParams contains parameters of the CartService.DeleteSavedItem
+type CartServiceMockDeleteSavedItemParams struct {
 	ctx    context.Context
 	userID int64
+	skuID  int64
 }
 
-// CartServiceMockGetCartParamPtrs contains pointers to parameters of the CartService.GetCart
-type CartServiceMockGetCartParamPtrs struct {
+// CartServiceMockDeleteSavedItemParamPtrs contains pointers to parameters of the CartService.DeleteSavedItem
+type CartServiceMockDeleteSavedItemParamPtrs struct {
 	ctx    *context.Context
 	userID *int64
+	skuID  *int64
 }
 
-// CartServiceMockGetCartResults contains results of the CartService.GetCart
-type CartServiceMockGetCartResults struct {
-	cp1 *domain.Cart
+// CartServiceMockDeleteSavedItemResults contains results of the CartService.DeleteSavedItem
+type CartServiceMockDeleteSavedItemResults struct {
 	err error
 }
 
-// CartServiceMockGetCartOrigins contains origins of expectations of the CartService.GetCart
-type CartServiceMockGetCartExpectationOrigins struct {
+// CartServiceMockDeleteSavedItemOrigins contains origins of expectations of the CartService.DeleteSavedItem
+type CartServiceMockDeleteSavedItemExpectationOrigins struct {
 	origin       string
 	originCtx    string
 	originUserID string
+	originSkuID  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1216,66 +1662,437 @@ type CartServiceMockGetCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetCart *mCartServiceMockGetCart) Optional() *mCartServiceMockGetCart {
-	mmGetCart.optional = true
-	return mmGetCart
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Optional() *mCartServiceMockDeleteSavedItem {
+	mmDeleteSavedItem.optional = true
+	return mmDeleteSavedItem
 }
 
-// Expect sets up expected params for CartService.GetCart
-func (mmGetCart *mCartServiceMockGetCart) Expect(ctx context.Context, userID int64) *mCartServiceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+// Expect sets up expected params for CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Expect(ctx context.Context, userID int64, skuID int64) *mCartServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartServiceMockGetCartExpectation{}
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartServiceMockDeleteSavedItemExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs != nil {
-		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by ExpectParams functions")
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by ExpectParams functions")
 	}
 
-	mmGetCart.defaultExpectation.params = &CartServiceMockGetCartParams{ctx, userID}
-	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetCart.expectations {
-		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
-			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+	mmDeleteSavedItem.defaultExpectation.params = &CartServiceMockDeleteSavedItemParams{ctx, userID, skuID}
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedItem.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedItem.defaultExpectation.params) {
+			mmDeleteSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedItem.defaultExpectation.params)
 		}
 	}
 
-	return mmGetCart
+	return mmDeleteSavedItem
 }
 
-// ExpectCtxParam1 sets up expected param ctx for CartService.GetCart
-func (mmGetCart *mCartServiceMockGetCart) ExpectCtxParam1(ctx context.Context) *mCartServiceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) ExpectCtxParam1(ctx context.Context) *mCartServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
-		mmGetCart.defaultExpectation = &CartServiceMockGetCartExpectation{}
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartServiceMockDeleteSavedItemExpectation{}
 	}
 
-	if mmGetCart.defaultExpectation.params != nil {
-		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Expect")
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Expect")
 	}
 
-	if mmGetCart.defaultExpectation.paramPtrs == nil {
-		mmGetCart.defaultExpectation.paramPtrs = &CartServiceMockGetCartParamPtrs{}
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartServiceMockDeleteSavedItemParamPtrs{}
 	}
-	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetCart
+	return mmDeleteSavedItem
 }
 
-// ExpectUserIDParam2 sets up expected param userID for CartService.GetCart
-func (mmGetCart *mCartServiceMockGetCart) ExpectUserIDParam2(userID int64) *mCartServiceMockGetCart {
-	if mmGetCart.mock.funcGetCart != nil {
-		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) ExpectUserIDParam2(userID int64) *mCartServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
 	}
 
-	if mmGetCart.defaultExpectation == nil {
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartServiceMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartServiceMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) ExpectSkuIDParam3(skuID int64) *mCartServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartServiceMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &CartServiceMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.skuID = &skuID
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mCartServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Inspect function is already set for CartServiceMock.DeleteSavedItem")
+	}
+
+	mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem = f
+
+	return mmDeleteSavedItem
+}
+
+// Return sets up results that will be returned by CartService.DeleteSavedItem
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Return(err error) *CartServiceMock {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &CartServiceMockDeleteSavedItemExpectation{mock: mmDeleteSavedItem.mock}
+	}
+	mmDeleteSavedItem.defaultExpectation.results = &CartServiceMockDeleteSavedItemResults{err}
+	mmDeleteSavedItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// Set uses given function f to mock the CartService.DeleteSavedItem method
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Set(f func(ctx context.Context, userID int64, skuID int64) (err error)) *CartServiceMock {
+	if mmDeleteSavedItem.defaultExpectation != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Default expectation is already set for the CartService.DeleteSavedItem method")
+	}
+
+	if len(mmDeleteSavedItem.expectations) > 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Some expectations are already set for the CartService.DeleteSavedItem method")
+	}
+
+	mmDeleteSavedItem.mock.funcDeleteSavedItem = f
+	mmDeleteSavedItem.mock.funcDeleteSavedItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// When sets expectation for the CartService.DeleteSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) When(ctx context.Context, userID int64, skuID int64) *CartServiceMockDeleteSavedItemExpectation {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("CartServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockDeleteSavedItemExpectation{
+		mock:               mmDeleteSavedItem.mock,
+		params:             &CartServiceMockDeleteSavedItemParams{ctx, userID, skuID},
+		expectationOrigins: CartServiceMockDeleteSavedItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedItem.expectations = append(mmDeleteSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.DeleteSavedItem return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockDeleteSavedItemExpectation) Then(err error) *CartServiceMock {
+	e.results = &CartServiceMockDeleteSavedItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartService.DeleteSavedItem should be invoked
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Times(n uint64) *mCartServiceMockDeleteSavedItem {
+	if n == 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Times of CartServiceMock.DeleteSavedItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedItem.expectedInvocations, n)
+	mmDeleteSavedItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem
+}
+
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) invocationsDone() bool {
+	if len(mmDeleteSavedItem.expectations) == 0 && mmDeleteSavedItem.defaultExpectation == nil && mmDeleteSavedItem.mock.funcDeleteSavedItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.mock.afterDeleteSavedItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedItem implements mm_handler.CartService
+func (mmDeleteSavedItem *CartServiceMock) DeleteSavedItem(ctx context.Context, userID int64, skuID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter, 1)
+
+	mmDeleteSavedItem.t.Helper()
+
+	if mmDeleteSavedItem.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.inspectFuncDeleteSavedItem(ctx, userID, skuID)
+	}
+
+	mm_params := CartServiceMockDeleteSavedItemParams{ctx, userID, skuID}
+
+	// Record call args
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Lock()
+	mmDeleteSavedItem.DeleteSavedItemMock.callArgs = append(mmDeleteSavedItem.DeleteSavedItemMock.callArgs, &mm_params)
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedItem.DeleteSavedItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockDeleteSavedItemParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedItem.t.Errorf("CartServiceMock.DeleteSavedItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSavedItem.t.Errorf("CartServiceMock.DeleteSavedItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmDeleteSavedItem.t.Errorf("CartServiceMock.DeleteSavedItem got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedItem.t.Errorf("CartServiceMock.DeleteSavedItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedItem.t.Fatal("No results are set for the CartServiceMock.DeleteSavedItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedItem.funcDeleteSavedItem != nil {
+		return mmDeleteSavedItem.funcDeleteSavedItem(ctx, userID, skuID)
+	}
+	mmDeleteSavedItem.t.Fatalf("Unexpected call to CartServiceMock.DeleteSavedItem. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// DeleteSavedItemAfterCounter returns a count of finished CartServiceMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartServiceMock) DeleteSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter)
+}
+
+// DeleteSavedItemBeforeCounter returns a count of CartServiceMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *CartServiceMock) DeleteSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.DeleteSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedItem *mCartServiceMockDeleteSavedItem) Calls() []*CartServiceMockDeleteSavedItemParams {
+	mmDeleteSavedItem.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockDeleteSavedItemParams, len(mmDeleteSavedItem.callArgs))
+	copy(argCopy, mmDeleteSavedItem.callArgs)
+
+	mmDeleteSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedItemDone returns true if the count of the DeleteSavedItem invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockDeleteSavedItemDone() bool {
+	if m.DeleteSavedItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedItemMock.invocationsDone()
+}
+
+// MinimockDeleteSavedItemInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockDeleteSavedItemInspect() {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.DeleteSavedItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedItemCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && afterDeleteSavedItemCounter < 1 {
+		if m.DeleteSavedItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.DeleteSavedItem at\n%s", m.DeleteSavedItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.DeleteSavedItem at\n%s with params: %#v", m.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && afterDeleteSavedItemCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.DeleteSavedItem at\n%s", m.funcDeleteSavedItemOrigin)
+	}
+
+	if !m.DeleteSavedItemMock.invocationsDone() && afterDeleteSavedItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.DeleteSavedItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedItemMock.expectedInvocations), m.DeleteSavedItemMock.expectedInvocationsOrigin, afterDeleteSavedItemCounter)
+	}
+}
+
+type mCartServiceMockGetCart struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockGetCartExpectation
+	expectations       []*CartServiceMockGetCartExpectation
+
+	callArgs []*CartServiceMockGetCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockGetCartExpectation specifies expectation struct of the CartService.GetCart
+type CartServiceMockGetCartExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockGetCartParams
+	paramPtrs          *CartServiceMockGetCartParamPtrs
+	expectationOrigins CartServiceMockGetCartExpectationOrigins
+	results            *CartServiceMockGetCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockGetCartParams contains parameters of the CartService.GetCart
+type CartServiceMockGetCartParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// CartServiceMockGetCartParamPtrs contains pointers to parameters of the CartService.GetCart
+type CartServiceMockGetCartParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// CartServiceMockGetCartResults contains results of the CartService.GetCart
+type CartServiceMockGetCartResults struct {
+	cp1 *domain.Cart
+	err error
+}
+
+// CartServiceMockGetCartOrigins contains origins of expectations of the CartService.GetCart
+type CartServiceMockGetCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCart *mCartServiceMockGetCart) Optional() *mCartServiceMockGetCart {
+	mmGetCart.optional = true
+	return mmGetCart
+}
+
+// Expect sets up expected params for CartService.GetCart
+func (mmGetCart *mCartServiceMockGetCart) Expect(ctx context.Context, userID int64) *mCartServiceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartServiceMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs != nil {
+		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by ExpectParams functions")
+	}
+
+	mmGetCart.defaultExpectation.params = &CartServiceMockGetCartParams{ctx, userID}
+	mmGetCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCart.expectations {
+		if minimock.Equal(e.params, mmGetCart.defaultExpectation.params) {
+			mmGetCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCart.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.GetCart
+func (mmGetCart *mCartServiceMockGetCart) ExpectCtxParam1(ctx context.Context) *mCartServiceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
+		mmGetCart.defaultExpectation = &CartServiceMockGetCartExpectation{}
+	}
+
+	if mmGetCart.defaultExpectation.params != nil {
+		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Expect")
+	}
+
+	if mmGetCart.defaultExpectation.paramPtrs == nil {
+		mmGetCart.defaultExpectation.paramPtrs = &CartServiceMockGetCartParamPtrs{}
+	}
+	mmGetCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.GetCart
+func (mmGetCart *mCartServiceMockGetCart) ExpectUserIDParam2(userID int64) *mCartServiceMockGetCart {
+	if mmGetCart.mock.funcGetCart != nil {
+		mmGetCart.mock.t.Fatalf("CartServiceMock.GetCart mock is already set by Set")
+	}
+
+	if mmGetCart.defaultExpectation == nil {
 		mmGetCart.defaultExpectation = &CartServiceMockGetCartExpectation{}
 	}
 
@@ -1505,17 +2322,1869 @@ func (m *CartServiceMock) MinimockGetCartInspect() {
 	}
 }
 
-// MinimockFinish checks that all mocked methods have been called the expected number of times
-func (m *CartServiceMock) MinimockFinish() {
-	m.finishOnce.Do(func() {
-		if !m.minimockDone() {
-			m.MinimockAddCartItemInspect()
+type mCartServiceMockGetSavedItems struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockGetSavedItemsExpectation
+	expectations       []*CartServiceMockGetSavedItemsExpectation
 
-			m.MinimockClearCartInspect()
+	callArgs []*CartServiceMockGetSavedItemsParams
+	mutex    sync.RWMutex
 
-			m.MinimockDeleteCartItemInspect()
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockGetSavedItemsExpectation specifies expectation struct of the CartService.GetSavedItems
+type CartServiceMockGetSavedItemsExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockGetSavedItemsParams
+	paramPtrs          *CartServiceMockGetSavedItemsParamPtrs
+	expectationOrigins CartServiceMockGetSavedItemsExpectationOrigins
+	results            *CartServiceMockGetSavedItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockGetSavedItemsParams contains parameters of the CartService.GetSavedItems
+type CartServiceMockGetSavedItemsParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// CartServiceMockGetSavedItemsParamPtrs contains pointers to parameters of the CartService.GetSavedItems
+type CartServiceMockGetSavedItemsParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// CartServiceMockGetSavedItemsResults contains results of the CartService.GetSavedItems
+type CartServiceMockGetSavedItemsResults struct {
+	cpa1 []*domain.CartItem
+	err  error
+}
+
+// CartServiceMockGetSavedItemsOrigins contains origins of expectations of the CartService.GetSavedItems
+type CartServiceMockGetSavedItemsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Optional() *mCartServiceMockGetSavedItems {
+	mmGetSavedItems.optional = true
+	return mmGetSavedItems
+}
+
+// Expect sets up expected params for CartService.GetSavedItems
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Expect(ctx context.Context, userID int64) *mCartServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by ExpectParams functions")
+	}
+
+	mmGetSavedItems.defaultExpectation.params = &CartServiceMockGetSavedItemsParams{ctx, userID}
+	mmGetSavedItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedItems.expectations {
+		if minimock.Equal(e.params, mmGetSavedItems.defaultExpectation.params) {
+			mmGetSavedItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItems.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.GetSavedItems
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) ExpectCtxParam1(ctx context.Context) *mCartServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &CartServiceMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.GetSavedItems
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) ExpectUserIDParam2(userID int64) *mCartServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &CartServiceMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.GetSavedItems
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Inspect(f func(ctx context.Context, userID int64)) *mCartServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("Inspect function is already set for CartServiceMock.GetSavedItems")
+	}
+
+	mmGetSavedItems.mock.inspectFuncGetSavedItems = f
+
+	return mmGetSavedItems
+}
+
+// Return sets up results that will be returned by CartService.GetSavedItems
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Return(cpa1 []*domain.CartItem, err error) *CartServiceMock {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &CartServiceMockGetSavedItemsExpectation{mock: mmGetSavedItems.mock}
+	}
+	mmGetSavedItems.defaultExpectation.results = &CartServiceMockGetSavedItemsResults{cpa1, err}
+	mmGetSavedItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// Set uses given function f to mock the CartService.GetSavedItems method
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Set(f func(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error)) *CartServiceMock {
+	if mmGetSavedItems.defaultExpectation != nil {
+		mmGetSavedItems.mock.t.Fatalf("Default expectation is already set for the CartService.GetSavedItems method")
+	}
+
+	if len(mmGetSavedItems.expectations) > 0 {
+		mmGetSavedItems.mock.t.Fatalf("Some expectations are already set for the CartService.GetSavedItems method")
+	}
+
+	mmGetSavedItems.mock.funcGetSavedItems = f
+	mmGetSavedItems.mock.funcGetSavedItemsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// When sets expectation for the CartService.GetSavedItems which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) When(ctx context.Context, userID int64) *CartServiceMockGetSavedItemsExpectation {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("CartServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockGetSavedItemsExpectation{
+		mock:               mmGetSavedItems.mock,
+		params:             &CartServiceMockGetSavedItemsParams{ctx, userID},
+		expectationOrigins: CartServiceMockGetSavedItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedItems.expectations = append(mmGetSavedItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.GetSavedItems return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockGetSavedItemsExpectation) Then(cpa1 []*domain.CartItem, err error) *CartServiceMock {
+	e.results = &CartServiceMockGetSavedItemsResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times CartService.GetSavedItems should be invoked
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Times(n uint64) *mCartServiceMockGetSavedItems {
+	if n == 0 {
+		mmGetSavedItems.mock.t.Fatalf("Times of CartServiceMock.GetSavedItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedItems.expectedInvocations, n)
+	mmGetSavedItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems
+}
+
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) invocationsDone() bool {
+	if len(mmGetSavedItems.expectations) == 0 && mmGetSavedItems.defaultExpectation == nil && mmGetSavedItems.mock.funcGetSavedItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.mock.afterGetSavedItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedItems implements mm_handler.CartService
+func (mmGetSavedItems *CartServiceMock) GetSavedItems(ctx context.Context, userID int64) (cpa1 []*domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItems.beforeGetSavedItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItems.afterGetSavedItemsCounter, 1)
+
+	mmGetSavedItems.t.Helper()
+
+	if mmGetSavedItems.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.inspectFuncGetSavedItems(ctx, userID)
+	}
+
+	mm_params := CartServiceMockGetSavedItemsParams{ctx, userID}
+
+	// Record call args
+	mmGetSavedItems.GetSavedItemsMock.mutex.Lock()
+	mmGetSavedItems.GetSavedItemsMock.callArgs = append(mmGetSavedItems.GetSavedItemsMock.callArgs, &mm_params)
+	mmGetSavedItems.GetSavedItemsMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItems.GetSavedItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetSavedItems.GetSavedItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItems.GetSavedItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockGetSavedItemsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedItems.t.Errorf("CartServiceMock.GetSavedItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSavedItems.t.Errorf("CartServiceMock.GetSavedItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItems.t.Errorf("CartServiceMock.GetSavedItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItems.t.Fatal("No results are set for the CartServiceMock.GetSavedItems")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetSavedItems.funcGetSavedItems != nil {
+		return mmGetSavedItems.funcGetSavedItems(ctx, userID)
+	}
+	mmGetSavedItems.t.Fatalf("Unexpected call to CartServiceMock.GetSavedItems. %v %v", ctx, userID)
+	return
+}
+
+// GetSavedItemsAfterCounter returns a count of finished CartServiceMock.GetSavedItems invocations
+func (mmGetSavedItems *CartServiceMock) GetSavedItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.afterGetSavedItemsCounter)
+}
+
+// GetSavedItemsBeforeCounter returns a count of CartServiceMock.GetSavedItems invocations
+func (mmGetSavedItems *CartServiceMock) GetSavedItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.beforeGetSavedItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.GetSavedItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItems *mCartServiceMockGetSavedItems) Calls() []*CartServiceMockGetSavedItemsParams {
+	mmGetSavedItems.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockGetSavedItemsParams, len(mmGetSavedItems.callArgs))
+	copy(argCopy, mmGetSavedItems.callArgs)
+
+	mmGetSavedItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemsDone returns true if the count of the GetSavedItems invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockGetSavedItemsDone() bool {
+	if m.GetSavedItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedItemsMock.invocationsDone()
+}
+
+// MinimockGetSavedItemsInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockGetSavedItemsInspect() {
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.GetSavedItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedItemsCounter := mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsMock.defaultExpectation != nil && afterGetSavedItemsCounter < 1 {
+		if m.GetSavedItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.GetSavedItems at\n%s", m.GetSavedItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.GetSavedItems at\n%s with params: %#v", m.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItems != nil && afterGetSavedItemsCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.GetSavedItems at\n%s", m.funcGetSavedItemsOrigin)
+	}
+
+	if !m.GetSavedItemsMock.invocationsDone() && afterGetSavedItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.GetSavedItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedItemsMock.expectedInvocations), m.GetSavedItemsMock.expectedInvocationsOrigin, afterGetSavedItemsCounter)
+	}
+}
+
+type mCartServiceMockMergeCarts struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockMergeCartsExpectation
+	expectations       []*CartServiceMockMergeCartsExpectation
+
+	callArgs []*CartServiceMockMergeCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockMergeCartsExpectation specifies expectation struct of the CartService.MergeCarts
+type CartServiceMockMergeCartsExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockMergeCartsParams
+	paramPtrs          *CartServiceMockMergeCartsParamPtrs
+	expectationOrigins CartServiceMockMergeCartsExpectationOrigins
+	results            *CartServiceMockMergeCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockMergeCartsParams contains parameters of the CartService.MergeCarts
+type CartServiceMockMergeCartsParams struct {
+	ctx         context.Context
+	userID      int64
+	guestCartID int64
+	policy      domain.MergePolicy
+}
+
+// CartServiceMockMergeCartsParamPtrs contains pointers to parameters of the CartService.MergeCarts
+type CartServiceMockMergeCartsParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	guestCartID *int64
+	policy      *domain.MergePolicy
+}
+
+// CartServiceMockMergeCartsResults contains results of the CartService.MergeCarts
+type CartServiceMockMergeCartsResults struct {
+	cp1 *domain.Cart
+	err error
+}
+
+// CartServiceMockMergeCartsOrigins contains origins of expectations of the CartService.MergeCarts
+type CartServiceMockMergeCartsExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originGuestCartID string
+	originPolicy      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergeCarts *mCartServiceMockMergeCarts) Optional() *mCartServiceMockMergeCarts {
+	mmMergeCarts.optional = true
+	return mmMergeCarts
+}
+
+// Expect sets up expected params for CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) Expect(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by ExpectParams functions")
+	}
+
+	mmMergeCarts.defaultExpectation.params = &CartServiceMockMergeCartsParams{ctx, userID, guestCartID, policy}
+	mmMergeCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergeCarts.expectations {
+		if minimock.Equal(e.params, mmMergeCarts.defaultExpectation.params) {
+			mmMergeCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) ExpectCtxParam1(ctx context.Context) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartServiceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergeCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) ExpectUserIDParam2(userID int64) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartServiceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.userID = &userID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectGuestCartIDParam3 sets up expected param guestCartID for CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) ExpectGuestCartIDParam3(guestCartID int64) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartServiceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.guestCartID = &guestCartID
+	mmMergeCarts.defaultExpectation.expectationOrigins.originGuestCartID = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// ExpectPolicyParam4 sets up expected param policy for CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) ExpectPolicyParam4(policy domain.MergePolicy) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{}
+	}
+
+	if mmMergeCarts.defaultExpectation.params != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Expect")
+	}
+
+	if mmMergeCarts.defaultExpectation.paramPtrs == nil {
+		mmMergeCarts.defaultExpectation.paramPtrs = &CartServiceMockMergeCartsParamPtrs{}
+	}
+	mmMergeCarts.defaultExpectation.paramPtrs.policy = &policy
+	mmMergeCarts.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmMergeCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) Inspect(f func(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy)) *mCartServiceMockMergeCarts {
+	if mmMergeCarts.mock.inspectFuncMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("Inspect function is already set for CartServiceMock.MergeCarts")
+	}
+
+	mmMergeCarts.mock.inspectFuncMergeCarts = f
+
+	return mmMergeCarts
+}
+
+// Return sets up results that will be returned by CartService.MergeCarts
+func (mmMergeCarts *mCartServiceMockMergeCarts) Return(cp1 *domain.Cart, err error) *CartServiceMock {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	if mmMergeCarts.defaultExpectation == nil {
+		mmMergeCarts.defaultExpectation = &CartServiceMockMergeCartsExpectation{mock: mmMergeCarts.mock}
+	}
+	mmMergeCarts.defaultExpectation.results = &CartServiceMockMergeCartsResults{cp1, err}
+	mmMergeCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// Set uses given function f to mock the CartService.MergeCarts method
+func (mmMergeCarts *mCartServiceMockMergeCarts) Set(f func(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy) (cp1 *domain.Cart, err error)) *CartServiceMock {
+	if mmMergeCarts.defaultExpectation != nil {
+		mmMergeCarts.mock.t.Fatalf("Default expectation is already set for the CartService.MergeCarts method")
+	}
+
+	if len(mmMergeCarts.expectations) > 0 {
+		mmMergeCarts.mock.t.Fatalf("Some expectations are already set for the CartService.MergeCarts method")
+	}
+
+	mmMergeCarts.mock.funcMergeCarts = f
+	mmMergeCarts.mock.funcMergeCartsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts.mock
+}
+
+// When sets expectation for the CartService.MergeCarts which will trigger the result defined by the following
+// Then helper
+func (mmMergeCarts *mCartServiceMockMergeCarts) When(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy) *CartServiceMockMergeCartsExpectation {
+	if mmMergeCarts.mock.funcMergeCarts != nil {
+		mmMergeCarts.mock.t.Fatalf("CartServiceMock.MergeCarts mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockMergeCartsExpectation{
+		mock:               mmMergeCarts.mock,
+		params:             &CartServiceMockMergeCartsParams{ctx, userID, guestCartID, policy},
+		expectationOrigins: CartServiceMockMergeCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergeCarts.expectations = append(mmMergeCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.MergeCarts return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockMergeCartsExpectation) Then(cp1 *domain.Cart, err error) *CartServiceMock {
+	e.results = &CartServiceMockMergeCartsResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartService.MergeCarts should be invoked
+func (mmMergeCarts *mCartServiceMockMergeCarts) Times(n uint64) *mCartServiceMockMergeCarts {
+	if n == 0 {
+		mmMergeCarts.mock.t.Fatalf("Times of CartServiceMock.MergeCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergeCarts.expectedInvocations, n)
+	mmMergeCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergeCarts
+}
+
+func (mmMergeCarts *mCartServiceMockMergeCarts) invocationsDone() bool {
+	if len(mmMergeCarts.expectations) == 0 && mmMergeCarts.defaultExpectation == nil && mmMergeCarts.mock.funcMergeCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergeCarts.mock.afterMergeCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergeCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergeCarts implements mm_handler.CartService
+func (mmMergeCarts *CartServiceMock) MergeCarts(ctx context.Context, userID int64, guestCartID int64, policy domain.MergePolicy) (cp1 *domain.Cart, err error) {
+	mm_atomic.AddUint64(&mmMergeCarts.beforeMergeCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCarts.afterMergeCartsCounter, 1)
+
+	mmMergeCarts.t.Helper()
+
+	if mmMergeCarts.inspectFuncMergeCarts != nil {
+		mmMergeCarts.inspectFuncMergeCarts(ctx, userID, guestCartID, policy)
+	}
+
+	mm_params := CartServiceMockMergeCartsParams{ctx, userID, guestCartID, policy}
+
+	// Record call args
+	mmMergeCarts.MergeCartsMock.mutex.Lock()
+	mmMergeCarts.MergeCartsMock.callArgs = append(mmMergeCarts.MergeCartsMock.callArgs, &mm_params)
+	mmMergeCarts.MergeCartsMock.mutex.Unlock()
+
+	for _, e := range mmMergeCarts.MergeCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmMergeCarts.MergeCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCarts.MergeCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCarts.MergeCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmMergeCarts.MergeCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockMergeCartsParams{ctx, userID, guestCartID, policy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergeCarts.t.Errorf("CartServiceMock.MergeCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMergeCarts.t.Errorf("CartServiceMock.MergeCarts got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.guestCartID != nil && !minimock.Equal(*mm_want_ptrs.guestCartID, mm_got.guestCartID) {
+				mmMergeCarts.t.Errorf("CartServiceMock.MergeCarts got unexpected parameter guestCartID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originGuestCartID, *mm_want_ptrs.guestCartID, mm_got.guestCartID, minimock.Diff(*mm_want_ptrs.guestCartID, mm_got.guestCartID))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmMergeCarts.t.Errorf("CartServiceMock.MergeCarts got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCarts.t.Errorf("CartServiceMock.MergeCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergeCarts.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCarts.MergeCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCarts.t.Fatal("No results are set for the CartServiceMock.MergeCarts")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmMergeCarts.funcMergeCarts != nil {
+		return mmMergeCarts.funcMergeCarts(ctx, userID, guestCartID, policy)
+	}
+	mmMergeCarts.t.Fatalf("Unexpected call to CartServiceMock.MergeCarts. %v %v %v %v", ctx, userID, guestCartID, policy)
+	return
+}
+
+// MergeCartsAfterCounter returns a count of finished CartServiceMock.MergeCarts invocations
+func (mmMergeCarts *CartServiceMock) MergeCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.afterMergeCartsCounter)
+}
+
+// MergeCartsBeforeCounter returns a count of CartServiceMock.MergeCarts invocations
+func (mmMergeCarts *CartServiceMock) MergeCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCarts.beforeMergeCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.MergeCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCarts *mCartServiceMockMergeCarts) Calls() []*CartServiceMockMergeCartsParams {
+	mmMergeCarts.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockMergeCartsParams, len(mmMergeCarts.callArgs))
+	copy(argCopy, mmMergeCarts.callArgs)
+
+	mmMergeCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartsDone returns true if the count of the MergeCarts invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockMergeCartsDone() bool {
+	if m.MergeCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeCartsMock.invocationsDone()
+}
+
+// MinimockMergeCartsInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockMergeCartsInspect() {
+	for _, e := range m.MergeCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.MergeCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCartsCounter := mm_atomic.LoadUint64(&m.afterMergeCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartsMock.defaultExpectation != nil && afterMergeCartsCounter < 1 {
+		if m.MergeCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.MergeCarts at\n%s", m.MergeCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.MergeCarts at\n%s with params: %#v", m.MergeCartsMock.defaultExpectation.expectationOrigins.origin, *m.MergeCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCarts != nil && afterMergeCartsCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.MergeCarts at\n%s", m.funcMergeCartsOrigin)
+	}
+
+	if !m.MergeCartsMock.invocationsDone() && afterMergeCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.MergeCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeCartsMock.expectedInvocations), m.MergeCartsMock.expectedInvocationsOrigin, afterMergeCartsCounter)
+	}
+}
+
+type mCartServiceMockMoveSavedItemToCart struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockMoveSavedItemToCartExpectation
+	expectations       []*CartServiceMockMoveSavedItemToCartExpectation
+
+	callArgs []*CartServiceMockMoveSavedItemToCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockMoveSavedItemToCartExpectation specifies expectation struct of the CartService.MoveSavedItemToCart
+type CartServiceMockMoveSavedItemToCartExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockMoveSavedItemToCartParams
+	paramPtrs          *CartServiceMockMoveSavedItemToCartParamPtrs
+	expectationOrigins CartServiceMockMoveSavedItemToCartExpectationOrigins
+	results            *CartServiceMockMoveSavedItemToCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockMoveSavedItemToCartParams contains parameters of the CartService.MoveSavedItemToCart
+type CartServiceMockMoveSavedItemToCartParams struct {
+	ctx    context.Context
+	userID int64
+	skuID  int64
+}
+
+// CartServiceMockMoveSavedItemToCartParamPtrs contains pointers to parameters of the CartService.MoveSavedItemToCart
+type CartServiceMockMoveSavedItemToCartParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	skuID  *int64
+}
+
+// CartServiceMockMoveSavedItemToCartResults contains results of the CartService.MoveSavedItemToCart
+type CartServiceMockMoveSavedItemToCartResults struct {
+	cp1 *domain.CartItem
+	err error
+}
+
+// CartServiceMockMoveSavedItemToCartOrigins contains origins of expectations of the CartService.MoveSavedItemToCart
+type CartServiceMockMoveSavedItemToCartExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Optional() *mCartServiceMockMoveSavedItemToCart {
+	mmMoveSavedItemToCart.optional = true
+	return mmMoveSavedItemToCart
+}
+
+// Expect sets up expected params for CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Expect(ctx context.Context, userID int64, skuID int64) *mCartServiceMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartServiceMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by ExpectParams functions")
+	}
+
+	mmMoveSavedItemToCart.defaultExpectation.params = &CartServiceMockMoveSavedItemToCartParams{ctx, userID, skuID}
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveSavedItemToCart.expectations {
+		if minimock.Equal(e.params, mmMoveSavedItemToCart.defaultExpectation.params) {
+			mmMoveSavedItemToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveSavedItemToCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) ExpectCtxParam1(ctx context.Context) *mCartServiceMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartServiceMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartServiceMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) ExpectUserIDParam2(userID int64) *mCartServiceMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartServiceMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartServiceMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.userID = &userID
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) ExpectSkuIDParam3(skuID int64) *mCartServiceMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartServiceMockMoveSavedItemToCartExpectation{}
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.params != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Expect")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveSavedItemToCart.defaultExpectation.paramPtrs = &CartServiceMockMoveSavedItemToCartParamPtrs{}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.paramPtrs.skuID = &skuID
+	mmMoveSavedItemToCart.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmMoveSavedItemToCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mCartServiceMockMoveSavedItemToCart {
+	if mmMoveSavedItemToCart.mock.inspectFuncMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Inspect function is already set for CartServiceMock.MoveSavedItemToCart")
+	}
+
+	mmMoveSavedItemToCart.mock.inspectFuncMoveSavedItemToCart = f
+
+	return mmMoveSavedItemToCart
+}
+
+// Return sets up results that will be returned by CartService.MoveSavedItemToCart
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Return(cp1 *domain.CartItem, err error) *CartServiceMock {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	if mmMoveSavedItemToCart.defaultExpectation == nil {
+		mmMoveSavedItemToCart.defaultExpectation = &CartServiceMockMoveSavedItemToCartExpectation{mock: mmMoveSavedItemToCart.mock}
+	}
+	mmMoveSavedItemToCart.defaultExpectation.results = &CartServiceMockMoveSavedItemToCartResults{cp1, err}
+	mmMoveSavedItemToCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart.mock
+}
+
+// Set uses given function f to mock the CartService.MoveSavedItemToCart method
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Set(f func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)) *CartServiceMock {
+	if mmMoveSavedItemToCart.defaultExpectation != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Default expectation is already set for the CartService.MoveSavedItemToCart method")
+	}
+
+	if len(mmMoveSavedItemToCart.expectations) > 0 {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Some expectations are already set for the CartService.MoveSavedItemToCart method")
+	}
+
+	mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart = f
+	mmMoveSavedItemToCart.mock.funcMoveSavedItemToCartOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart.mock
+}
+
+// When sets expectation for the CartService.MoveSavedItemToCart which will trigger the result defined by the following
+// Then helper
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) When(ctx context.Context, userID int64, skuID int64) *CartServiceMockMoveSavedItemToCartExpectation {
+	if mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.mock.t.Fatalf("CartServiceMock.MoveSavedItemToCart mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockMoveSavedItemToCartExpectation{
+		mock:               mmMoveSavedItemToCart.mock,
+		params:             &CartServiceMockMoveSavedItemToCartParams{ctx, userID, skuID},
+		expectationOrigins: CartServiceMockMoveSavedItemToCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveSavedItemToCart.expectations = append(mmMoveSavedItemToCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.MoveSavedItemToCart return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockMoveSavedItemToCartExpectation) Then(cp1 *domain.CartItem, err error) *CartServiceMock {
+	e.results = &CartServiceMockMoveSavedItemToCartResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartService.MoveSavedItemToCart should be invoked
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Times(n uint64) *mCartServiceMockMoveSavedItemToCart {
+	if n == 0 {
+		mmMoveSavedItemToCart.mock.t.Fatalf("Times of CartServiceMock.MoveSavedItemToCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveSavedItemToCart.expectedInvocations, n)
+	mmMoveSavedItemToCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveSavedItemToCart
+}
+
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) invocationsDone() bool {
+	if len(mmMoveSavedItemToCart.expectations) == 0 && mmMoveSavedItemToCart.defaultExpectation == nil && mmMoveSavedItemToCart.mock.funcMoveSavedItemToCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveSavedItemToCart.mock.afterMoveSavedItemToCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveSavedItemToCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveSavedItemToCart implements mm_handler.CartService
+func (mmMoveSavedItemToCart *CartServiceMock) MoveSavedItemToCart(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmMoveSavedItemToCart.beforeMoveSavedItemToCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveSavedItemToCart.afterMoveSavedItemToCartCounter, 1)
+
+	mmMoveSavedItemToCart.t.Helper()
+
+	if mmMoveSavedItemToCart.inspectFuncMoveSavedItemToCart != nil {
+		mmMoveSavedItemToCart.inspectFuncMoveSavedItemToCart(ctx, userID, skuID)
+	}
+
+	mm_params := CartServiceMockMoveSavedItemToCartParams{ctx, userID, skuID}
+
+	// Record call args
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.mutex.Lock()
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.callArgs = append(mmMoveSavedItemToCart.MoveSavedItemToCartMock.callArgs, &mm_params)
+	mmMoveSavedItemToCart.MoveSavedItemToCartMock.mutex.Unlock()
+
+	for _, e := range mmMoveSavedItemToCart.MoveSavedItemToCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockMoveSavedItemToCartParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveSavedItemToCart.t.Errorf("CartServiceMock.MoveSavedItemToCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMoveSavedItemToCart.t.Errorf("CartServiceMock.MoveSavedItemToCart got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmMoveSavedItemToCart.t.Errorf("CartServiceMock.MoveSavedItemToCart got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveSavedItemToCart.t.Errorf("CartServiceMock.MoveSavedItemToCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveSavedItemToCart.MoveSavedItemToCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveSavedItemToCart.t.Fatal("No results are set for the CartServiceMock.MoveSavedItemToCart")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmMoveSavedItemToCart.funcMoveSavedItemToCart != nil {
+		return mmMoveSavedItemToCart.funcMoveSavedItemToCart(ctx, userID, skuID)
+	}
+	mmMoveSavedItemToCart.t.Fatalf("Unexpected call to CartServiceMock.MoveSavedItemToCart. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// MoveSavedItemToCartAfterCounter returns a count of finished CartServiceMock.MoveSavedItemToCart invocations
+func (mmMoveSavedItemToCart *CartServiceMock) MoveSavedItemToCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveSavedItemToCart.afterMoveSavedItemToCartCounter)
+}
+
+// MoveSavedItemToCartBeforeCounter returns a count of CartServiceMock.MoveSavedItemToCart invocations
+func (mmMoveSavedItemToCart *CartServiceMock) MoveSavedItemToCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveSavedItemToCart.beforeMoveSavedItemToCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.MoveSavedItemToCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveSavedItemToCart *mCartServiceMockMoveSavedItemToCart) Calls() []*CartServiceMockMoveSavedItemToCartParams {
+	mmMoveSavedItemToCart.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockMoveSavedItemToCartParams, len(mmMoveSavedItemToCart.callArgs))
+	copy(argCopy, mmMoveSavedItemToCart.callArgs)
+
+	mmMoveSavedItemToCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveSavedItemToCartDone returns true if the count of the MoveSavedItemToCart invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockMoveSavedItemToCartDone() bool {
+	if m.MoveSavedItemToCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveSavedItemToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveSavedItemToCartMock.invocationsDone()
+}
+
+// MinimockMoveSavedItemToCartInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockMoveSavedItemToCartInspect() {
+	for _, e := range m.MoveSavedItemToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.MoveSavedItemToCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveSavedItemToCartCounter := mm_atomic.LoadUint64(&m.afterMoveSavedItemToCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveSavedItemToCartMock.defaultExpectation != nil && afterMoveSavedItemToCartCounter < 1 {
+		if m.MoveSavedItemToCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.MoveSavedItemToCart at\n%s", m.MoveSavedItemToCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.MoveSavedItemToCart at\n%s with params: %#v", m.MoveSavedItemToCartMock.defaultExpectation.expectationOrigins.origin, *m.MoveSavedItemToCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveSavedItemToCart != nil && afterMoveSavedItemToCartCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.MoveSavedItemToCart at\n%s", m.funcMoveSavedItemToCartOrigin)
+	}
+
+	if !m.MoveSavedItemToCartMock.invocationsDone() && afterMoveSavedItemToCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.MoveSavedItemToCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveSavedItemToCartMock.expectedInvocations), m.MoveSavedItemToCartMock.expectedInvocationsOrigin, afterMoveSavedItemToCartCounter)
+	}
+}
+
+type mCartServiceMockRemovePromoCode struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockRemovePromoCodeExpectation
+	expectations       []*CartServiceMockRemovePromoCodeExpectation
+
+	callArgs []*CartServiceMockRemovePromoCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockRemovePromoCodeExpectation specifies expectation struct of the CartService.RemovePromoCode
+type CartServiceMockRemovePromoCodeExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockRemovePromoCodeParams
+	paramPtrs          *CartServiceMockRemovePromoCodeParamPtrs
+	expectationOrigins CartServiceMockRemovePromoCodeExpectationOrigins
+	results            *CartServiceMockRemovePromoCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockRemovePromoCodeParams contains parameters of the CartService.RemovePromoCode
+type CartServiceMockRemovePromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// CartServiceMockRemovePromoCodeParamPtrs contains pointers to parameters of the CartService.RemovePromoCode
+type CartServiceMockRemovePromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// CartServiceMockRemovePromoCodeResults contains results of the CartService.RemovePromoCode
+type CartServiceMockRemovePromoCodeResults struct {
+	err error
+}
+
+// CartServiceMockRemovePromoCodeOrigins contains origins of expectations of the CartService.RemovePromoCode
+type CartServiceMockRemovePromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Optional() *mCartServiceMockRemovePromoCode {
+	mmRemovePromoCode.optional = true
+	return mmRemovePromoCode
+}
+
+// Expect sets up expected params for CartService.RemovePromoCode
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Expect(ctx context.Context, userID int64) *mCartServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &CartServiceMockRemovePromoCodeExpectation{}
+	}
+
+	if mmRemovePromoCode.defaultExpectation.paramPtrs != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePromoCode.defaultExpectation.params = &CartServiceMockRemovePromoCodeParams{ctx, userID}
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePromoCode.expectations {
+		if minimock.Equal(e.params, mmRemovePromoCode.defaultExpectation.params) {
+			mmRemovePromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePromoCode.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePromoCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.RemovePromoCode
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) ExpectCtxParam1(ctx context.Context) *mCartServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &CartServiceMockRemovePromoCodeExpectation{}
+	}
+
+	if mmRemovePromoCode.defaultExpectation.params != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Expect")
+	}
+
+	if mmRemovePromoCode.defaultExpectation.paramPtrs == nil {
+		mmRemovePromoCode.defaultExpectation.paramPtrs = &CartServiceMockRemovePromoCodeParamPtrs{}
+	}
+	mmRemovePromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePromoCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.RemovePromoCode
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) ExpectUserIDParam2(userID int64) *mCartServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &CartServiceMockRemovePromoCodeExpectation{}
+	}
+
+	if mmRemovePromoCode.defaultExpectation.params != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Expect")
+	}
+
+	if mmRemovePromoCode.defaultExpectation.paramPtrs == nil {
+		mmRemovePromoCode.defaultExpectation.paramPtrs = &CartServiceMockRemovePromoCodeParamPtrs{}
+	}
+	mmRemovePromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmRemovePromoCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.RemovePromoCode
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Inspect(f func(ctx context.Context, userID int64)) *mCartServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.inspectFuncRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("Inspect function is already set for CartServiceMock.RemovePromoCode")
+	}
+
+	mmRemovePromoCode.mock.inspectFuncRemovePromoCode = f
+
+	return mmRemovePromoCode
+}
+
+// Return sets up results that will be returned by CartService.RemovePromoCode
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Return(err error) *CartServiceMock {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &CartServiceMockRemovePromoCodeExpectation{mock: mmRemovePromoCode.mock}
+	}
+	mmRemovePromoCode.defaultExpectation.results = &CartServiceMockRemovePromoCodeResults{err}
+	mmRemovePromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode.mock
+}
+
+// Set uses given function f to mock the CartService.RemovePromoCode method
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Set(f func(ctx context.Context, userID int64) (err error)) *CartServiceMock {
+	if mmRemovePromoCode.defaultExpectation != nil {
+		mmRemovePromoCode.mock.t.Fatalf("Default expectation is already set for the CartService.RemovePromoCode method")
+	}
+
+	if len(mmRemovePromoCode.expectations) > 0 {
+		mmRemovePromoCode.mock.t.Fatalf("Some expectations are already set for the CartService.RemovePromoCode method")
+	}
+
+	mmRemovePromoCode.mock.funcRemovePromoCode = f
+	mmRemovePromoCode.mock.funcRemovePromoCodeOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode.mock
+}
+
+// When sets expectation for the CartService.RemovePromoCode which will trigger the result defined by the following
+// Then helper
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) When(ctx context.Context, userID int64) *CartServiceMockRemovePromoCodeExpectation {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("CartServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockRemovePromoCodeExpectation{
+		mock:               mmRemovePromoCode.mock,
+		params:             &CartServiceMockRemovePromoCodeParams{ctx, userID},
+		expectationOrigins: CartServiceMockRemovePromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemovePromoCode.expectations = append(mmRemovePromoCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.RemovePromoCode return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockRemovePromoCodeExpectation) Then(err error) *CartServiceMock {
+	e.results = &CartServiceMockRemovePromoCodeResults{err}
+	return e.mock
+}
+
+// Times sets number of times CartService.RemovePromoCode should be invoked
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Times(n uint64) *mCartServiceMockRemovePromoCode {
+	if n == 0 {
+		mmRemovePromoCode.mock.t.Fatalf("Times of CartServiceMock.RemovePromoCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemovePromoCode.expectedInvocations, n)
+	mmRemovePromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode
+}
+
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) invocationsDone() bool {
+	if len(mmRemovePromoCode.expectations) == 0 && mmRemovePromoCode.defaultExpectation == nil && mmRemovePromoCode.mock.funcRemovePromoCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePromoCode.mock.afterRemovePromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePromoCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemovePromoCode implements mm_handler.CartService
+func (mmRemovePromoCode *CartServiceMock) RemovePromoCode(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemovePromoCode.beforeRemovePromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePromoCode.afterRemovePromoCodeCounter, 1)
+
+	mmRemovePromoCode.t.Helper()
+
+	if mmRemovePromoCode.inspectFuncRemovePromoCode != nil {
+		mmRemovePromoCode.inspectFuncRemovePromoCode(ctx, userID)
+	}
+
+	mm_params := CartServiceMockRemovePromoCodeParams{ctx, userID}
+
+	// Record call args
+	mmRemovePromoCode.RemovePromoCodeMock.mutex.Lock()
+	mmRemovePromoCode.RemovePromoCodeMock.callArgs = append(mmRemovePromoCode.RemovePromoCodeMock.callArgs, &mm_params)
+	mmRemovePromoCode.RemovePromoCodeMock.mutex.Unlock()
+
+	for _, e := range mmRemovePromoCode.RemovePromoCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockRemovePromoCodeParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemovePromoCode.t.Errorf("CartServiceMock.RemovePromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemovePromoCode.t.Errorf("CartServiceMock.RemovePromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemovePromoCode.t.Errorf("CartServiceMock.RemovePromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemovePromoCode.t.Fatal("No results are set for the CartServiceMock.RemovePromoCode")
+		}
+		return (*mm_results).err
+	}
+	if mmRemovePromoCode.funcRemovePromoCode != nil {
+		return mmRemovePromoCode.funcRemovePromoCode(ctx, userID)
+	}
+	mmRemovePromoCode.t.Fatalf("Unexpected call to CartServiceMock.RemovePromoCode. %v %v", ctx, userID)
+	return
+}
+
+// RemovePromoCodeAfterCounter returns a count of finished CartServiceMock.RemovePromoCode invocations
+func (mmRemovePromoCode *CartServiceMock) RemovePromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromoCode.afterRemovePromoCodeCounter)
+}
+
+// RemovePromoCodeBeforeCounter returns a count of CartServiceMock.RemovePromoCode invocations
+func (mmRemovePromoCode *CartServiceMock) RemovePromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromoCode.beforeRemovePromoCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.RemovePromoCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemovePromoCode *mCartServiceMockRemovePromoCode) Calls() []*CartServiceMockRemovePromoCodeParams {
+	mmRemovePromoCode.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockRemovePromoCodeParams, len(mmRemovePromoCode.callArgs))
+	copy(argCopy, mmRemovePromoCode.callArgs)
+
+	mmRemovePromoCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemovePromoCodeDone returns true if the count of the RemovePromoCode invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockRemovePromoCodeDone() bool {
+	if m.RemovePromoCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemovePromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemovePromoCodeMock.invocationsDone()
+}
+
+// MinimockRemovePromoCodeInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockRemovePromoCodeInspect() {
+	for _, e := range m.RemovePromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.RemovePromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemovePromoCodeCounter := mm_atomic.LoadUint64(&m.afterRemovePromoCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemovePromoCodeMock.defaultExpectation != nil && afterRemovePromoCodeCounter < 1 {
+		if m.RemovePromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.RemovePromoCode at\n%s", m.RemovePromoCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.RemovePromoCode at\n%s with params: %#v", m.RemovePromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.RemovePromoCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemovePromoCode != nil && afterRemovePromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.RemovePromoCode at\n%s", m.funcRemovePromoCodeOrigin)
+	}
+
+	if !m.RemovePromoCodeMock.invocationsDone() && afterRemovePromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.RemovePromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePromoCodeMock.expectedInvocations), m.RemovePromoCodeMock.expectedInvocationsOrigin, afterRemovePromoCodeCounter)
+	}
+}
+
+type mCartServiceMockSaveCartItemForLater struct {
+	optional           bool
+	mock               *CartServiceMock
+	defaultExpectation *CartServiceMockSaveCartItemForLaterExpectation
+	expectations       []*CartServiceMockSaveCartItemForLaterExpectation
+
+	callArgs []*CartServiceMockSaveCartItemForLaterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// CartServiceMockSaveCartItemForLaterExpectation specifies expectation struct of the CartService.SaveCartItemForLater
+type CartServiceMockSaveCartItemForLaterExpectation struct {
+	mock               *CartServiceMock
+	params             *CartServiceMockSaveCartItemForLaterParams
+	paramPtrs          *CartServiceMockSaveCartItemForLaterParamPtrs
+	expectationOrigins CartServiceMockSaveCartItemForLaterExpectationOrigins
+	results            *CartServiceMockSaveCartItemForLaterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// CartServiceMockSaveCartItemForLaterParams contains parameters of the CartService.SaveCartItemForLater
+type CartServiceMockSaveCartItemForLaterParams struct {
+	ctx    context.Context
+	userID int64
+	skuID  int64
+}
+
+// CartServiceMockSaveCartItemForLaterParamPtrs contains pointers to parameters of the CartService.SaveCartItemForLater
+type CartServiceMockSaveCartItemForLaterParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	skuID  *int64
+}
+
+// CartServiceMockSaveCartItemForLaterResults contains results of the CartService.SaveCartItemForLater
+type CartServiceMockSaveCartItemForLaterResults struct {
+	cp1 *domain.CartItem
+	err error
+}
+
+// CartServiceMockSaveCartItemForLaterOrigins contains origins of expectations of the CartService.SaveCartItemForLater
+type CartServiceMockSaveCartItemForLaterExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSkuID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Optional() *mCartServiceMockSaveCartItemForLater {
+	mmSaveCartItemForLater.optional = true
+	return mmSaveCartItemForLater
+}
+
+// Expect sets up expected params for CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Expect(ctx context.Context, userID int64, skuID int64) *mCartServiceMockSaveCartItemForLater {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation == nil {
+		mmSaveCartItemForLater.defaultExpectation = &CartServiceMockSaveCartItemForLaterExpectation{}
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.paramPtrs != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by ExpectParams functions")
+	}
+
+	mmSaveCartItemForLater.defaultExpectation.params = &CartServiceMockSaveCartItemForLaterParams{ctx, userID, skuID}
+	mmSaveCartItemForLater.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveCartItemForLater.expectations {
+		if minimock.Equal(e.params, mmSaveCartItemForLater.defaultExpectation.params) {
+			mmSaveCartItemForLater.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveCartItemForLater.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveCartItemForLater
+}
+
+// ExpectCtxParam1 sets up expected param ctx for CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) ExpectCtxParam1(ctx context.Context) *mCartServiceMockSaveCartItemForLater {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation == nil {
+		mmSaveCartItemForLater.defaultExpectation = &CartServiceMockSaveCartItemForLaterExpectation{}
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.params != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Expect")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveCartItemForLater.defaultExpectation.paramPtrs = &CartServiceMockSaveCartItemForLaterParamPtrs{}
+	}
+	mmSaveCartItemForLater.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveCartItemForLater.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSaveCartItemForLater
+}
+
+// ExpectUserIDParam2 sets up expected param userID for CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) ExpectUserIDParam2(userID int64) *mCartServiceMockSaveCartItemForLater {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation == nil {
+		mmSaveCartItemForLater.defaultExpectation = &CartServiceMockSaveCartItemForLaterExpectation{}
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.params != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Expect")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveCartItemForLater.defaultExpectation.paramPtrs = &CartServiceMockSaveCartItemForLaterParamPtrs{}
+	}
+	mmSaveCartItemForLater.defaultExpectation.paramPtrs.userID = &userID
+	mmSaveCartItemForLater.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSaveCartItemForLater
+}
+
+// ExpectSkuIDParam3 sets up expected param skuID for CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) ExpectSkuIDParam3(skuID int64) *mCartServiceMockSaveCartItemForLater {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation == nil {
+		mmSaveCartItemForLater.defaultExpectation = &CartServiceMockSaveCartItemForLaterExpectation{}
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.params != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Expect")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveCartItemForLater.defaultExpectation.paramPtrs = &CartServiceMockSaveCartItemForLaterParamPtrs{}
+	}
+	mmSaveCartItemForLater.defaultExpectation.paramPtrs.skuID = &skuID
+	mmSaveCartItemForLater.defaultExpectation.expectationOrigins.originSkuID = minimock.CallerInfo(1)
+
+	return mmSaveCartItemForLater
+}
+
+// Inspect accepts an inspector function that has same arguments as the CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Inspect(f func(ctx context.Context, userID int64, skuID int64)) *mCartServiceMockSaveCartItemForLater {
+	if mmSaveCartItemForLater.mock.inspectFuncSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("Inspect function is already set for CartServiceMock.SaveCartItemForLater")
+	}
+
+	mmSaveCartItemForLater.mock.inspectFuncSaveCartItemForLater = f
+
+	return mmSaveCartItemForLater
+}
+
+// Return sets up results that will be returned by CartService.SaveCartItemForLater
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Return(cp1 *domain.CartItem, err error) *CartServiceMock {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	if mmSaveCartItemForLater.defaultExpectation == nil {
+		mmSaveCartItemForLater.defaultExpectation = &CartServiceMockSaveCartItemForLaterExpectation{mock: mmSaveCartItemForLater.mock}
+	}
+	mmSaveCartItemForLater.defaultExpectation.results = &CartServiceMockSaveCartItemForLaterResults{cp1, err}
+	mmSaveCartItemForLater.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveCartItemForLater.mock
+}
+
+// Set uses given function f to mock the CartService.SaveCartItemForLater method
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Set(f func(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error)) *CartServiceMock {
+	if mmSaveCartItemForLater.defaultExpectation != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("Default expectation is already set for the CartService.SaveCartItemForLater method")
+	}
+
+	if len(mmSaveCartItemForLater.expectations) > 0 {
+		mmSaveCartItemForLater.mock.t.Fatalf("Some expectations are already set for the CartService.SaveCartItemForLater method")
+	}
+
+	mmSaveCartItemForLater.mock.funcSaveCartItemForLater = f
+	mmSaveCartItemForLater.mock.funcSaveCartItemForLaterOrigin = minimock.CallerInfo(1)
+	return mmSaveCartItemForLater.mock
+}
+
+// When sets expectation for the CartService.SaveCartItemForLater which will trigger the result defined by the following
+// Then helper
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) When(ctx context.Context, userID int64, skuID int64) *CartServiceMockSaveCartItemForLaterExpectation {
+	if mmSaveCartItemForLater.mock.funcSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.mock.t.Fatalf("CartServiceMock.SaveCartItemForLater mock is already set by Set")
+	}
+
+	expectation := &CartServiceMockSaveCartItemForLaterExpectation{
+		mock:               mmSaveCartItemForLater.mock,
+		params:             &CartServiceMockSaveCartItemForLaterParams{ctx, userID, skuID},
+		expectationOrigins: CartServiceMockSaveCartItemForLaterExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSaveCartItemForLater.expectations = append(mmSaveCartItemForLater.expectations, expectation)
+	return expectation
+}
+
+// Then sets up CartService.SaveCartItemForLater return parameters for the expectation previously defined by the When method
+func (e *CartServiceMockSaveCartItemForLaterExpectation) Then(cp1 *domain.CartItem, err error) *CartServiceMock {
+	e.results = &CartServiceMockSaveCartItemForLaterResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times CartService.SaveCartItemForLater should be invoked
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Times(n uint64) *mCartServiceMockSaveCartItemForLater {
+	if n == 0 {
+		mmSaveCartItemForLater.mock.t.Fatalf("Times of CartServiceMock.SaveCartItemForLater mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSaveCartItemForLater.expectedInvocations, n)
+	mmSaveCartItemForLater.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveCartItemForLater
+}
+
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) invocationsDone() bool {
+	if len(mmSaveCartItemForLater.expectations) == 0 && mmSaveCartItemForLater.defaultExpectation == nil && mmSaveCartItemForLater.mock.funcSaveCartItemForLater == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveCartItemForLater.mock.afterSaveCartItemForLaterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveCartItemForLater.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SaveCartItemForLater implements mm_handler.CartService
+func (mmSaveCartItemForLater *CartServiceMock) SaveCartItemForLater(ctx context.Context, userID int64, skuID int64) (cp1 *domain.CartItem, err error) {
+	mm_atomic.AddUint64(&mmSaveCartItemForLater.beforeSaveCartItemForLaterCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveCartItemForLater.afterSaveCartItemForLaterCounter, 1)
+
+	mmSaveCartItemForLater.t.Helper()
+
+	if mmSaveCartItemForLater.inspectFuncSaveCartItemForLater != nil {
+		mmSaveCartItemForLater.inspectFuncSaveCartItemForLater(ctx, userID, skuID)
+	}
+
+	mm_params := CartServiceMockSaveCartItemForLaterParams{ctx, userID, skuID}
+
+	// Record call args
+	mmSaveCartItemForLater.SaveCartItemForLaterMock.mutex.Lock()
+	mmSaveCartItemForLater.SaveCartItemForLaterMock.callArgs = append(mmSaveCartItemForLater.SaveCartItemForLaterMock.callArgs, &mm_params)
+	mmSaveCartItemForLater.SaveCartItemForLaterMock.mutex.Unlock()
+
+	for _, e := range mmSaveCartItemForLater.SaveCartItemForLaterMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.paramPtrs
+
+		mm_got := CartServiceMockSaveCartItemForLaterParams{ctx, userID, skuID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSaveCartItemForLater.t.Errorf("CartServiceMock.SaveCartItemForLater got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSaveCartItemForLater.t.Errorf("CartServiceMock.SaveCartItemForLater got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.skuID != nil && !minimock.Equal(*mm_want_ptrs.skuID, mm_got.skuID) {
+				mmSaveCartItemForLater.t.Errorf("CartServiceMock.SaveCartItemForLater got unexpected parameter skuID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.expectationOrigins.originSkuID, *mm_want_ptrs.skuID, mm_got.skuID, minimock.Diff(*mm_want_ptrs.skuID, mm_got.skuID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSaveCartItemForLater.t.Errorf("CartServiceMock.SaveCartItemForLater got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSaveCartItemForLater.SaveCartItemForLaterMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSaveCartItemForLater.t.Fatal("No results are set for the CartServiceMock.SaveCartItemForLater")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmSaveCartItemForLater.funcSaveCartItemForLater != nil {
+		return mmSaveCartItemForLater.funcSaveCartItemForLater(ctx, userID, skuID)
+	}
+	mmSaveCartItemForLater.t.Fatalf("Unexpected call to CartServiceMock.SaveCartItemForLater. %v %v %v", ctx, userID, skuID)
+	return
+}
+
+// SaveCartItemForLaterAfterCounter returns a count of finished CartServiceMock.SaveCartItemForLater invocations
+func (mmSaveCartItemForLater *CartServiceMock) SaveCartItemForLaterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCartItemForLater.afterSaveCartItemForLaterCounter)
+}
+
+// SaveCartItemForLaterBeforeCounter returns a count of CartServiceMock.SaveCartItemForLater invocations
+func (mmSaveCartItemForLater *CartServiceMock) SaveCartItemForLaterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveCartItemForLater.beforeSaveCartItemForLaterCounter)
+}
+
+// Calls returns a list of arguments used in each call to CartServiceMock.SaveCartItemForLater.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSaveCartItemForLater *mCartServiceMockSaveCartItemForLater) Calls() []*CartServiceMockSaveCartItemForLaterParams {
+	mmSaveCartItemForLater.mutex.RLock()
+
+	argCopy := make([]*CartServiceMockSaveCartItemForLaterParams, len(mmSaveCartItemForLater.callArgs))
+	copy(argCopy, mmSaveCartItemForLater.callArgs)
+
+	mmSaveCartItemForLater.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSaveCartItemForLaterDone returns true if the count of the SaveCartItemForLater invocations corresponds
+// the number of defined expectations
+func (m *CartServiceMock) MinimockSaveCartItemForLaterDone() bool {
+	if m.SaveCartItemForLaterMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SaveCartItemForLaterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SaveCartItemForLaterMock.invocationsDone()
+}
+
+// MinimockSaveCartItemForLaterInspect logs each unmet expectation
+func (m *CartServiceMock) MinimockSaveCartItemForLaterInspect() {
+	for _, e := range m.SaveCartItemForLaterMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to CartServiceMock.SaveCartItemForLater at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSaveCartItemForLaterCounter := mm_atomic.LoadUint64(&m.afterSaveCartItemForLaterCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SaveCartItemForLaterMock.defaultExpectation != nil && afterSaveCartItemForLaterCounter < 1 {
+		if m.SaveCartItemForLaterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to CartServiceMock.SaveCartItemForLater at\n%s", m.SaveCartItemForLaterMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to CartServiceMock.SaveCartItemForLater at\n%s with params: %#v", m.SaveCartItemForLaterMock.defaultExpectation.expectationOrigins.origin, *m.SaveCartItemForLaterMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSaveCartItemForLater != nil && afterSaveCartItemForLaterCounter < 1 {
+		m.t.Errorf("Expected call to CartServiceMock.SaveCartItemForLater at\n%s", m.funcSaveCartItemForLaterOrigin)
+	}
+
+	if !m.SaveCartItemForLaterMock.invocationsDone() && afterSaveCartItemForLaterCounter > 0 {
+		m.t.Errorf("Expected %d calls to CartServiceMock.SaveCartItemForLater at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveCartItemForLaterMock.expectedInvocations), m.SaveCartItemForLaterMock.expectedInvocationsOrigin, afterSaveCartItemForLaterCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *CartServiceMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddCartItemInspect()
+
+			m.MinimockApplyPromoCodeInspect()
+
+			m.MinimockClearCartInspect()
+
+			m.MinimockDeleteCartItemInspect()
+
+			m.MinimockDeleteSavedItemInspect()
 
 			m.MinimockGetCartInspect()
+
+			m.MinimockGetSavedItemsInspect()
+
+			m.MinimockMergeCartsInspect()
+
+			m.MinimockMoveSavedItemToCartInspect()
+
+			m.MinimockRemovePromoCodeInspect()
+
+			m.MinimockSaveCartItemForLaterInspect()
 		}
 	})
 }
@@ -1540,7 +4209,14 @@ func (m *CartServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddCartItemDone() &&
+		m.MinimockApplyPromoCodeDone() &&
 		m.MinimockClearCartDone() &&
 		m.MinimockDeleteCartItemDone() &&
-		m.MinimockGetCartDone()
+		m.MinimockDeleteSavedItemDone() &&
+		m.MinimockGetCartDone() &&
+		m.MinimockGetSavedItemsDone() &&
+		m.MinimockMergeCartsDone() &&
+		m.MinimockMoveSavedItemToCartDone() &&
+		m.MinimockRemovePromoCodeDone() &&
+		m.MinimockSaveCartItemForLaterDone()
 }
@@ -5,6 +5,7 @@ package mocks
 //go:generate minimock -i route256/cart/internal/service.RateLimiter -o rate_limiter_mock.go -n RateLimiterMock -p mocks
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -17,9 +18,9 @@ type RateLimiterMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAcquire          func()
+	funcAcquire          func(ctx context.Context) (err error)
 	funcAcquireOrigin    string
-	inspectFuncAcquire   func()
+	inspectFuncAcquire   func(ctx context.Context)
 	afterAcquireCounter  uint64
 	beforeAcquireCounter uint64
 	AcquireMock          mRateLimiterMockAcquire
@@ -34,6 +35,7 @@ func NewRateLimiterMock(t minimock.Tester) *RateLimiterMock {
 	}
 
 	m.AcquireMock = mRateLimiterMockAcquire{mock: m}
+	m.AcquireMock.callArgs = []*RateLimiterMockAcquireParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
@@ -46,16 +48,43 @@ type mRateLimiterMockAcquire struct {
 	defaultExpectation *RateLimiterMockAcquireExpectation
 	expectations       []*RateLimiterMockAcquireExpectation
 
+	callArgs []*RateLimiterMockAcquireParams
+	mutex    sync.RWMutex
+
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
 // RateLimiterMockAcquireExpectation specifies expectation struct of the RateLimiter.Acquire
 type RateLimiterMockAcquireExpectation struct {
-	mock *RateLimiterMock
+	mock               *RateLimiterMock
+	params             *RateLimiterMockAcquireParams
+	paramPtrs          *RateLimiterMockAcquireParamPtrs
+	expectationOrigins RateLimiterMockAcquireExpectationOrigins
+	results            *RateLimiterMockAcquireResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RateLimiterMockAcquireParams contains parameters of the RateLimiter.Acquire
+type RateLimiterMockAcquireParams struct {
+	ctx context.Context
+}
+
+// RateLimiterMockAcquireParamPtrs contains pointers to parameters of the RateLimiter.Acquire
+type RateLimiterMockAcquireParamPtrs struct {
+	ctx *context.Context
+}
 
-	returnOrigin string
-	Counter      uint64
+// RateLimiterMockAcquireResults contains results of the RateLimiter.Acquire
+type RateLimiterMockAcquireResults struct {
+	err error
+}
+
+// RateLimiterMockAcquireOrigins contains origins of expectations of the RateLimiter.Acquire
+type RateLimiterMockAcquireExpectationOrigins struct {
+	origin    string
+	originCtx string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -69,7 +98,32 @@ func (mmAcquire *mRateLimiterMockAcquire) Optional() *mRateLimiterMockAcquire {
 }
 
 // Expect sets up expected params for RateLimiter.Acquire
-func (mmAcquire *mRateLimiterMockAcquire) Expect() *mRateLimiterMockAcquire {
+func (mmAcquire *mRateLimiterMockAcquire) Expect(ctx context.Context) *mRateLimiterMockAcquire {
+	if mmAcquire.mock.funcAcquire != nil {
+		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by Set")
+	}
+
+	if mmAcquire.defaultExpectation == nil {
+		mmAcquire.defaultExpectation = &RateLimiterMockAcquireExpectation{}
+	}
+
+	if mmAcquire.defaultExpectation.paramPtrs != nil {
+		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by ExpectParams functions")
+	}
+
+	mmAcquire.defaultExpectation.params = &RateLimiterMockAcquireParams{ctx}
+	mmAcquire.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAcquire.expectations {
+		if minimock.Equal(e.params, mmAcquire.defaultExpectation.params) {
+			mmAcquire.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAcquire.defaultExpectation.params)
+		}
+	}
+
+	return mmAcquire
+}
+
+// ExpectCtxParam1 sets up expected param ctx for RateLimiter.Acquire
+func (mmAcquire *mRateLimiterMockAcquire) ExpectCtxParam1(ctx context.Context) *mRateLimiterMockAcquire {
 	if mmAcquire.mock.funcAcquire != nil {
 		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by Set")
 	}
@@ -78,11 +132,21 @@ func (mmAcquire *mRateLimiterMockAcquire) Expect() *mRateLimiterMockAcquire {
 		mmAcquire.defaultExpectation = &RateLimiterMockAcquireExpectation{}
 	}
 
+	if mmAcquire.defaultExpectation.params != nil {
+		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by Expect")
+	}
+
+	if mmAcquire.defaultExpectation.paramPtrs == nil {
+		mmAcquire.defaultExpectation.paramPtrs = &RateLimiterMockAcquireParamPtrs{}
+	}
+	mmAcquire.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAcquire.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
 	return mmAcquire
 }
 
 // Inspect accepts an inspector function that has same arguments as the RateLimiter.Acquire
-func (mmAcquire *mRateLimiterMockAcquire) Inspect(f func()) *mRateLimiterMockAcquire {
+func (mmAcquire *mRateLimiterMockAcquire) Inspect(f func(ctx context.Context)) *mRateLimiterMockAcquire {
 	if mmAcquire.mock.inspectFuncAcquire != nil {
 		mmAcquire.mock.t.Fatalf("Inspect function is already set for RateLimiterMock.Acquire")
 	}
@@ -93,7 +157,7 @@ func (mmAcquire *mRateLimiterMockAcquire) Inspect(f func()) *mRateLimiterMockAcq
 }
 
 // Return sets up results that will be returned by RateLimiter.Acquire
-func (mmAcquire *mRateLimiterMockAcquire) Return() *RateLimiterMock {
+func (mmAcquire *mRateLimiterMockAcquire) Return(err error) *RateLimiterMock {
 	if mmAcquire.mock.funcAcquire != nil {
 		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by Set")
 	}
@@ -101,13 +165,13 @@ func (mmAcquire *mRateLimiterMockAcquire) Return() *RateLimiterMock {
 	if mmAcquire.defaultExpectation == nil {
 		mmAcquire.defaultExpectation = &RateLimiterMockAcquireExpectation{mock: mmAcquire.mock}
 	}
-
+	mmAcquire.defaultExpectation.results = &RateLimiterMockAcquireResults{err}
 	mmAcquire.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmAcquire.mock
 }
 
 // Set uses given function f to mock the RateLimiter.Acquire method
-func (mmAcquire *mRateLimiterMockAcquire) Set(f func()) *RateLimiterMock {
+func (mmAcquire *mRateLimiterMockAcquire) Set(f func(ctx context.Context) (err error)) *RateLimiterMock {
 	if mmAcquire.defaultExpectation != nil {
 		mmAcquire.mock.t.Fatalf("Default expectation is already set for the RateLimiter.Acquire method")
 	}
@@ -121,6 +185,28 @@ func (mmAcquire *mRateLimiterMockAcquire) Set(f func()) *RateLimiterMock {
 	return mmAcquire.mock
 }
 
+// When sets expectation for the RateLimiter.Acquire which will trigger the result defined by the following
+// Then helper
+func (mmAcquire *mRateLimiterMockAcquire) When(ctx context.Context) *RateLimiterMockAcquireExpectation {
+	if mmAcquire.mock.funcAcquire != nil {
+		mmAcquire.mock.t.Fatalf("RateLimiterMock.Acquire mock is already set by Set")
+	}
+
+	expectation := &RateLimiterMockAcquireExpectation{
+		mock:               mmAcquire.mock,
+		params:             &RateLimiterMockAcquireParams{ctx},
+		expectationOrigins: RateLimiterMockAcquireExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAcquire.expectations = append(mmAcquire.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RateLimiter.Acquire return parameters for the expectation previously defined by the When method
+func (e *RateLimiterMockAcquireExpectation) Then(err error) *RateLimiterMock {
+	e.results = &RateLimiterMockAcquireResults{err}
+	return e.mock
+}
+
 // Times sets number of times RateLimiter.Acquire should be invoked
 func (mmAcquire *mRateLimiterMockAcquire) Times(n uint64) *mRateLimiterMockAcquire {
 	if n == 0 {
@@ -143,28 +229,60 @@ func (mmAcquire *mRateLimiterMockAcquire) invocationsDone() bool {
 }
 
 // Acquire implements mm_service.RateLimiter
-func (mmAcquire *RateLimiterMock) Acquire() {
+func (mmAcquire *RateLimiterMock) Acquire(ctx context.Context) (err error) {
 	mm_atomic.AddUint64(&mmAcquire.beforeAcquireCounter, 1)
 	defer mm_atomic.AddUint64(&mmAcquire.afterAcquireCounter, 1)
 
 	mmAcquire.t.Helper()
 
 	if mmAcquire.inspectFuncAcquire != nil {
-		mmAcquire.inspectFuncAcquire()
+		mmAcquire.inspectFuncAcquire(ctx)
+	}
+
+	mm_params := RateLimiterMockAcquireParams{ctx}
+
+	// Record call args
+	mmAcquire.AcquireMock.mutex.Lock()
+	mmAcquire.AcquireMock.callArgs = append(mmAcquire.AcquireMock.callArgs, &mm_params)
+	mmAcquire.AcquireMock.mutex.Unlock()
+
+	for _, e := range mmAcquire.AcquireMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
 	}
 
 	if mmAcquire.AcquireMock.defaultExpectation != nil {
 		mm_atomic.AddUint64(&mmAcquire.AcquireMock.defaultExpectation.Counter, 1)
+		mm_want := mmAcquire.AcquireMock.defaultExpectation.params
+		mm_want_ptrs := mmAcquire.AcquireMock.defaultExpectation.paramPtrs
+
+		mm_got := RateLimiterMockAcquireParams{ctx}
+
+		if mm_want_ptrs != nil {
 
-		return
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcquire.t.Errorf("RateLimiterMock.Acquire got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcquire.AcquireMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
 
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAcquire.t.Errorf("RateLimiterMock.Acquire got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAcquire.AcquireMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAcquire.AcquireMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAcquire.t.Fatal("No results are set for the RateLimiterMock.Acquire")
+		}
+		return (*mm_results).err
 	}
 	if mmAcquire.funcAcquire != nil {
-		mmAcquire.funcAcquire()
-		return
+		return mmAcquire.funcAcquire(ctx)
 	}
-	mmAcquire.t.Fatalf("Unexpected call to RateLimiterMock.Acquire.")
-
+	mmAcquire.t.Fatalf("Unexpected call to RateLimiterMock.Acquire. %v", ctx)
+	return
 }
 
 // AcquireAfterCounter returns a count of finished RateLimiterMock.Acquire invocations
@@ -177,6 +295,19 @@ func (mmAcquire *RateLimiterMock) AcquireBeforeCounter() uint64 {
 	return mm_atomic.LoadUint64(&mmAcquire.beforeAcquireCounter)
 }
 
+// Calls returns a list of arguments used in each call to RateLimiterMock.Acquire.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAcquire *mRateLimiterMockAcquire) Calls() []*RateLimiterMockAcquireParams {
+	mmAcquire.mutex.RLock()
+
+	argCopy := make([]*RateLimiterMockAcquireParams, len(mmAcquire.callArgs))
+	copy(argCopy, mmAcquire.callArgs)
+
+	mmAcquire.mutex.RUnlock()
+
+	return argCopy
+}
+
 // MinimockAcquireDone returns true if the count of the Acquire invocations corresponds
 // the number of defined expectations
 func (m *RateLimiterMock) MinimockAcquireDone() bool {
@@ -198,14 +329,18 @@ func (m *RateLimiterMock) MinimockAcquireDone() bool {
 func (m *RateLimiterMock) MinimockAcquireInspect() {
 	for _, e := range m.AcquireMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Error("Expected call to RateLimiterMock.Acquire")
+			m.t.Errorf("Expected call to RateLimiterMock.Acquire at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
 	afterAcquireCounter := mm_atomic.LoadUint64(&m.afterAcquireCounter)
 	// if default expectation was set then invocations count should be greater than zero
 	if m.AcquireMock.defaultExpectation != nil && afterAcquireCounter < 1 {
-		m.t.Errorf("Expected call to RateLimiterMock.Acquire at\n%s", m.AcquireMock.defaultExpectation.returnOrigin)
+		if m.AcquireMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RateLimiterMock.Acquire at\n%s", m.AcquireMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RateLimiterMock.Acquire at\n%s with params: %#v", m.AcquireMock.defaultExpectation.expectationOrigins.origin, *m.AcquireMock.defaultExpectation.params)
+		}
 	}
 	// if func was set then invocations count should be greater than zero
 	if m.funcAcquire != nil && afterAcquireCounter < 1 {
@@ -0,0 +1,142 @@
+package circuitbreaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"route256/cart/pkg/metrics"
+)
+
+// ErrOpen возвращается, когда breaker разомкнут и запрос не выполняется.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State состояние breaker.
+type State int
+
+const (
+	// Closed запросы выполняются, ошибки подсчитываются.
+	Closed State = iota
+	// Open запросы отклоняются до истечения OpenTimeout.
+	Open
+	// HalfOpen выполняется ограниченное число пробных запросов.
+	HalfOpen
+)
+
+// Config настройки breaker.
+type Config struct {
+	// FailureThreshold количество ошибок подряд, после которого breaker размыкается.
+	FailureThreshold int
+	// OpenTimeout время, через которое разомкнутый breaker пропускает пробные запросы.
+	OpenTimeout time.Duration
+	// HalfOpenMaxRequests количество одновременных пробных запросов.
+	HalfOpenMaxRequests int
+}
+
+// Breaker реализует паттерн circuit breaker для вызовов внешнего ресурса.
+type Breaker struct {
+	name string
+	cfg  Config
+	now  func() time.Time
+
+	mu               sync.Mutex
+	state            State
+	failures         int
+	openedAt         time.Time
+	halfOpenInFlight int
+}
+
+// New создает новый Breaker. name используется как метка метрик.
+func New(name string, cfg Config) *Breaker {
+	if cfg.HalfOpenMaxRequests <= 0 {
+		cfg.HalfOpenMaxRequests = 1
+	}
+
+	b := &Breaker{
+		name: name,
+		cfg:  cfg,
+		now:  time.Now,
+	}
+	metrics.StoreCircuitBreakerState(name, int(Closed))
+
+	return b
+}
+
+// Allow проверяет, можно ли выполнить запрос. Если можно, после запроса нужно вызвать Done.
+func (b *Breaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == Open && b.now().Sub(b.openedAt) >= b.cfg.OpenTimeout {
+		b.setState(HalfOpen)
+	}
+
+	switch b.state {
+	case Open:
+		metrics.IncCircuitBreakerRejectedCount(b.name)
+		return ErrOpen
+	case HalfOpen:
+		if b.halfOpenInFlight >= b.cfg.HalfOpenMaxRequests {
+			metrics.IncCircuitBreakerRejectedCount(b.name)
+			return ErrOpen
+		}
+		b.halfOpenInFlight++
+	}
+
+	return nil
+}
+
+// Done сообщает результат запроса, разрешенного Allow.
+func (b *Breaker) Done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.halfOpenInFlight--
+	}
+
+	if success {
+		b.failures = 0
+		if b.state == HalfOpen {
+			b.setState(Closed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == HalfOpen || b.failures >= b.cfg.FailureThreshold {
+		b.openedAt = b.now()
+		b.setState(Open)
+	}
+}
+
+// Release освобождает разрешение, полученное от Allow, если запрос не был выполнен.
+// Результат запроса при этом не учитывается.
+func (b *Breaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == HalfOpen {
+		b.halfOpenInFlight--
+	}
+}
+
+// State возвращает текущее состояние breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.state
+}
+
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+
+	b.state = state
+	if state != HalfOpen {
+		b.halfOpenInFlight = 0
+	}
+	metrics.StoreCircuitBreakerState(b.name, int(state))
+}
@@ -0,0 +1,117 @@
+package circuitbreaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBreaker(t *testing.T, now *time.Time) *Breaker {
+	t.Helper()
+
+	b := New(t.Name(), Config{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Second,
+	})
+	b.now = func() time.Time { return *now }
+
+	return b
+}
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		b := newTestBreaker(t, &now)
+
+		for range 2 {
+			require.NoError(t, b.Allow())
+			b.Done(false)
+		}
+
+		assert.Equal(t, Open, b.State())
+		assert.ErrorIs(t, b.Allow(), ErrOpen)
+	})
+
+	t.Run("success resets failures", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		b := newTestBreaker(t, &now)
+
+		require.NoError(t, b.Allow())
+		b.Done(false)
+		require.NoError(t, b.Allow())
+		b.Done(true)
+		require.NoError(t, b.Allow())
+		b.Done(false)
+
+		assert.Equal(t, Closed, b.State())
+	})
+
+	t.Run("half-open probe closes breaker on success", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		b := newTestBreaker(t, &now)
+
+		for range 2 {
+			require.NoError(t, b.Allow())
+			b.Done(false)
+		}
+
+		now = now.Add(time.Second)
+
+		require.NoError(t, b.Allow())
+		assert.Equal(t, HalfOpen, b.State())
+		assert.ErrorIs(t, b.Allow(), ErrOpen, "only one probe allowed")
+
+		b.Done(true)
+		assert.Equal(t, Closed, b.State())
+		assert.NoError(t, b.Allow())
+	})
+
+	t.Run("half-open probe failure reopens breaker", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		b := newTestBreaker(t, &now)
+
+		for range 2 {
+			require.NoError(t, b.Allow())
+			b.Done(false)
+		}
+
+		now = now.Add(time.Second)
+
+		require.NoError(t, b.Allow())
+		b.Done(false)
+
+		assert.Equal(t, Open, b.State())
+		assert.ErrorIs(t, b.Allow(), ErrOpen)
+	})
+	t.Run("release frees half-open slot without changing state", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		b := newTestBreaker(t, &now)
+
+		for range 2 {
+			require.NoError(t, b.Allow())
+			b.Done(false)
+		}
+
+		now = now.Add(time.Second)
+
+		require.NoError(t, b.Allow())
+		b.Release()
+
+		assert.Equal(t, HalfOpen, b.State())
+		assert.NoError(t, b.Allow(), "released slot can be reused")
+	})
+}
@@ -0,0 +1,119 @@
+package lrucache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// Cache потокобезопасный LRU-кэш ограниченного размера с TTL для каждой записи.
+type Cache[K comparable, V any] struct {
+	capacity int
+	now      func() time.Time
+
+	mu    sync.Mutex
+	order *list.List
+	items map[K]*list.Element
+}
+
+// New создает Cache, хранящий не более capacity записей.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return &Cache[K, V]{
+		capacity: max(capacity, 1),
+		now:      time.Now,
+		order:    list.New(),
+		items:    make(map[K]*list.Element, capacity),
+	}
+}
+
+// Get возвращает значение по ключу, если запись есть и не устарела.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var zero V
+
+	el, ok := c.items[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := el.Value.(*entry[K, V])
+	if !c.now().Before(e.expiresAt) {
+		return zero, false
+	}
+
+	c.order.MoveToFront(el)
+
+	return e.value, true
+}
+
+// GetStale возвращает значение по ключу, даже если его TTL истек.
+// Устаревшие записи хранятся, пока не будут вытеснены или перезаписаны.
+func (c *Cache[K, V]) GetStale(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return el.Value.(*entry[K, V]).value, true
+}
+
+// Set сохраняет значение на время ttl. При переполнении вытесняется давно не использованная запись.
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := c.now().Add(ttl)
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[K, V]{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+
+	if c.order.Len() > c.capacity {
+		c.removeElement(c.order.Back())
+	}
+}
+
+// Delete удаляет запись по ключу.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+// Len возвращает количество записей, включая устаревшие.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *Cache[K, V]) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,103 @@
+package lrucache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestCache(t *testing.T, capacity int, now *time.Time) *Cache[int, string] {
+	t.Helper()
+
+	c := New[int, string](capacity)
+	c.now = func() time.Time { return *now }
+
+	return c
+}
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get after set", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		c := newTestCache(t, 2, &now)
+
+		c.Set(1, "one", time.Minute)
+
+		value, ok := c.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "one", value)
+
+		_, ok = c.Get(2)
+		assert.False(t, ok)
+	})
+
+	t.Run("entry expires after ttl", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		c := newTestCache(t, 2, &now)
+
+		c.Set(1, "one", time.Minute)
+		now = now.Add(time.Minute)
+
+		_, ok := c.Get(1)
+		assert.False(t, ok)
+
+		value, ok := c.GetStale(1)
+		assert.True(t, ok)
+		assert.Equal(t, "one", value)
+	})
+
+	t.Run("least recently used entry evicted", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		c := newTestCache(t, 2, &now)
+
+		c.Set(1, "one", time.Minute)
+		c.Set(2, "two", time.Minute)
+		c.Get(1)
+		c.Set(3, "three", time.Minute)
+
+		_, ok := c.Get(2)
+		assert.False(t, ok)
+		_, ok = c.Get(1)
+		assert.True(t, ok)
+		_, ok = c.Get(3)
+		assert.True(t, ok)
+		assert.Equal(t, 2, c.Len())
+	})
+
+	t.Run("set overrides value and ttl", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		c := newTestCache(t, 2, &now)
+
+		c.Set(1, "one", time.Second)
+		c.Set(1, "uno", time.Minute)
+		now = now.Add(time.Second)
+
+		value, ok := c.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "uno", value)
+		assert.Equal(t, 1, c.Len())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		c := newTestCache(t, 2, &now)
+
+		c.Set(1, "one", time.Minute)
+		c.Delete(1)
+
+		_, ok := c.Get(1)
+		assert.False(t, ok)
+	})
+}
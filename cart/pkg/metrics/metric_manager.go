@@ -48,18 +48,55 @@ var (
 	requestToDBCounter = promauto.NewCounterVec(prometheus.CounterOpts{
 		Name: "database_requests_total",
 		Help: "Total count of request",
-	}, []string{"category"})
+	}, []string{"pool", "category"})
 
 	requestToDBDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
 		Name:    "database_request_duration_seconds",
 		Help:    "Duration of request to database",
 		Buckets: prometheus.DefBuckets,
-	}, []string{"category", "error"})
+	}, []string{"pool", "category", "error"})
 
 	repositorySizeGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "repository_objects_count",
 		Help: "Current number of objects in the repository",
 	}, []string{"object"})
+
+	notificationCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "notifications_total",
+		Help: "Total count of notification deliveries",
+	}, []string{"channel", "result"})
+
+	notificationDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "notification_delivery_duration_seconds",
+		Help:    "Duration of notification delivery",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"channel", "error"})
+
+	circuitBreakerStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "circuit_breaker_state",
+		Help: "Current circuit breaker state (0 - closed, 1 - open, 2 - half-open)",
+	}, []string{"name"})
+
+	circuitBreakerRejectedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "circuit_breaker_rejected_total",
+		Help: "Total count of requests rejected by circuit breaker",
+	}, []string{"name"})
+
+	rateLimiterWaitHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "rate_limiter_wait_duration_seconds",
+		Help:    "Time spent waiting for a rate limiter token",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"name", "result"})
+
+	rateLimiterLimitGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "rate_limiter_limit",
+		Help: "Current rate limiter limit in requests per second",
+	}, []string{"name"})
+
+	cacheRequestCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Total count of cache lookups by result (hit, negative_hit, miss)",
+	}, []string{"cache", "result"})
 )
 
 // IncRequestCount увеличивает метрику счетчика запросов для указанного обработчика.
@@ -86,20 +123,20 @@ func AddRequestToExternalDurationHist(resource string, status int, duration time
 		Observe(float64(duration.Seconds()))
 }
 
-// IncDBRequestCount увеличивает метрику счетчика запросов к базе данных по категории.
-func IncDBRequestCount(category DBRequestCategory) {
-	requestToDBCounter.WithLabelValues(category).Inc()
+// IncDBRequestCount увеличивает метрику счетчика запросов к базе данных по пулу и категории.
+func IncDBRequestCount(pool string, category DBRequestCategory) {
+	requestToDBCounter.WithLabelValues(pool, category).Inc()
 }
 
 // AddDBRequestDurationHist добавляет значение в гистограмму длительности запроса к базе данных.
-func AddDBRequestDurationHist(category DBRequestCategory, err error, duration time.Duration) {
+func AddDBRequestDurationHist(pool string, category DBRequestCategory, err error, duration time.Duration) {
 	errData := "no"
 	if err != nil {
 		errData = "yes"
 	}
 
 	requestToDBDurationHistogram.
-		WithLabelValues(category, errData).
+		WithLabelValues(pool, category, errData).
 		Observe(float64(duration.Seconds()))
 }
 
@@ -107,3 +144,47 @@ func AddDBRequestDurationHist(category DBRequestCategory, err error, duration ti
 func StoreRepositorySize(objectName string, size float64) {
 	repositorySizeGauge.WithLabelValues(objectName).Set(size)
 }
+
+// IncNotificationCount увеличивает метрику счетчика доставки уведомлений по каналу и результату.
+func IncNotificationCount(channel string, result string) {
+	notificationCounter.WithLabelValues(channel, result).Inc()
+}
+
+// AddNotificationDurationHist добавляет значение в гистограмму длительности доставки уведомления.
+func AddNotificationDurationHist(channel string, err error, duration time.Duration) {
+	errData := "no"
+	if err != nil {
+		errData = "yes"
+	}
+
+	notificationDurationHistogram.
+		WithLabelValues(channel, errData).
+		Observe(float64(duration.Seconds()))
+}
+
+// StoreCircuitBreakerState сохраняет текущее состояние circuit breaker.
+func StoreCircuitBreakerState(name string, state int) {
+	circuitBreakerStateGauge.WithLabelValues(name).Set(float64(state))
+}
+
+// IncCircuitBreakerRejectedCount увеличивает метрику счетчика запросов, отклоненных circuit breaker.
+func IncCircuitBreakerRejectedCount(name string) {
+	circuitBreakerRejectedCounter.WithLabelValues(name).Inc()
+}
+
+// AddRateLimiterWaitHist добавляет значение в гистограмму времени ожидания токена rate limiter.
+func AddRateLimiterWaitHist(name string, result string, duration time.Duration) {
+	rateLimiterWaitHistogram.
+		WithLabelValues(name, result).
+		Observe(float64(duration.Seconds()))
+}
+
+// StoreRateLimiterLimit сохраняет текущий лимит rate limiter.
+func StoreRateLimiterLimit(name string, limit float64) {
+	rateLimiterLimitGauge.WithLabelValues(name).Set(limit)
+}
+
+// IncCacheRequestCount увеличивает метрику счетчика обращений к кэшу по результату.
+func IncCacheRequestCount(cache string, result string) {
+	cacheRequestCounter.WithLabelValues(cache, result).Inc()
+}
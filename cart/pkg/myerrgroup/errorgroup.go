@@ -2,59 +2,175 @@ package myerrgroup
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
 	"sync"
 	"sync/atomic"
 )
 
+// PanicError ошибка, в которую преобразуется паника внутри горутины группы.
+type PanicError struct {
+	Recovered any
+	Stack     []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("recovered from panic: %v\n%s", e.Recovered, e.Stack)
+}
+
+// Option настраивает ErrorGroup.
+type Option func(*ErrorGroup)
+
+// WithCollectAll включает сбор всех ошибок: Wait возвращает их объединение (errors.Join),
+// а ошибка одной горутины не отменяет контекст и не останавливает остальные.
+func WithCollectAll() Option {
+	return func(g *ErrorGroup) {
+		g.collectAll = true
+	}
+}
+
 // ErrorGroup реализует группу горутин с обработкой ошибок и отменой контекста.
 type ErrorGroup struct {
-	cancel context.CancelFunc
-	wg     sync.WaitGroup
-	err    error
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+	sem        chan struct{}
+	collectAll bool
+
+	mu     sync.Mutex
+	errs   []error
 	wasErr atomic.Bool
 }
 
 // New создает новый ErrorGroup.
-func New() *ErrorGroup {
-	return &ErrorGroup{}
+func New(opts ...Option) *ErrorGroup {
+	g := &ErrorGroup{}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	return g
 }
 
 // WithContext создает ErrorGroup с поддержкой отмены контекста.
-func WithContext(ctx context.Context) (*ErrorGroup, context.Context) {
+func WithContext(ctx context.Context, opts ...Option) (*ErrorGroup, context.Context) {
 	derivedCtx, cancel := context.WithCancel(ctx)
 
-	return &ErrorGroup{
-		cancel: cancel,
-	}, derivedCtx
+	g := New(opts...)
+	g.cancel = cancel
+
+	return g, derivedCtx
+}
+
+// SetLimit ограничивает количество одновременно работающих горутин группы.
+// Отрицательное значение снимает ограничение. Нельзя вызывать, пока в группе есть активные горутины.
+func (g *ErrorGroup) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+
+	if len(g.sem) != 0 {
+		panic(fmt.Errorf("myerrgroup: modify limit while %v goroutines in the group are still active", len(g.sem)))
+	}
+
+	g.sem = make(chan struct{}, n)
 }
 
 // Go запускает функцию в отдельной горутине, отслеживая ошибку.
+// Если задан лимит, блокируется до освобождения места в группе.
 func (g *ErrorGroup) Go(f func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.run(f)
+}
+
+// TryGo запускает функцию в отдельной горутине, только если лимит группы не исчерпан.
+// Возвращает false, если горутина не была запущена.
+func (g *ErrorGroup) TryGo(f func() error) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+
+	g.run(f)
+
+	return true
+}
+
+func (g *ErrorGroup) run(f func() error) {
 	g.wg.Add(1)
 	go func() {
-		defer g.wg.Done()
+		defer g.done()
 
-		if g.wasErr.Load() {
+		if !g.collectAll && g.wasErr.Load() {
 			return
 		}
 
-		err := f()
-		if err != nil {
-			if g.wasErr.CompareAndSwap(false, true) {
-				g.err = err
-				if g.cancel != nil {
-					g.cancel()
-				}
-			}
+		if err := call(f); err != nil {
+			g.setErr(err)
+		}
+	}()
+}
+
+func (g *ErrorGroup) done() {
+	if g.sem != nil {
+		<-g.sem
+	}
+	g.wg.Done()
+}
+
+func (g *ErrorGroup) setErr(err error) {
+	if g.collectAll {
+		g.mu.Lock()
+		g.errs = append(g.errs, err)
+		g.mu.Unlock()
+		return
+	}
+
+	if g.wasErr.CompareAndSwap(false, true) {
+		g.mu.Lock()
+		g.errs = append(g.errs, err)
+		g.mu.Unlock()
+
+		if g.cancel != nil {
+			g.cancel()
+		}
+	}
+}
+
+func call(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Recovered: r, Stack: debug.Stack()}
 		}
 	}()
+
+	return f()
 }
 
-// Wait ожидает завершения всех горутин и возвращает первую ошибку.
+// Wait ожидает завершения всех горутин и возвращает первую ошибку
+// или объединение всех ошибок, если включен WithCollectAll.
 func (g *ErrorGroup) Wait() error {
 	g.wg.Wait()
 	if g.cancel != nil {
 		g.cancel()
 	}
-	return g.err
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if len(g.errs) == 0 {
+		return nil
+	}
+	if !g.collectAll {
+		return g.errs[0]
+	}
+
+	return errors.Join(g.errs...)
 }
@@ -3,7 +3,9 @@ package myerrgroup
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -65,3 +67,102 @@ func TestErrorGroup(t *testing.T) {
 		}
 	})
 }
+
+func TestErrorGroupLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("limits active goroutines", func(t *testing.T) {
+		t.Parallel()
+
+		const limit = 2
+
+		errGroup := New()
+		errGroup.SetLimit(limit)
+
+		var active, maxActive atomic.Int32
+		for range 10 {
+			errGroup.Go(func() error {
+				cur := active.Add(1)
+				defer active.Add(-1)
+
+				for {
+					prev := maxActive.Load()
+					if cur <= prev || maxActive.CompareAndSwap(prev, cur) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond)
+				return nil
+			})
+		}
+
+		require.NoError(t, errGroup.Wait())
+		assert.LessOrEqual(t, maxActive.Load(), int32(limit))
+	})
+
+	t.Run("try go fails when limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		errGroup := New()
+		errGroup.SetLimit(1)
+
+		release := make(chan struct{})
+		require.True(t, errGroup.TryGo(func() error {
+			<-release
+			return nil
+		}))
+		assert.False(t, errGroup.TryGo(func() error {
+			return nil
+		}))
+
+		close(release)
+		require.NoError(t, errGroup.Wait())
+
+		assert.True(t, errGroup.TryGo(func() error {
+			return nil
+		}))
+		require.NoError(t, errGroup.Wait())
+	})
+}
+
+func TestErrorGroupCollectAll(t *testing.T) {
+	t.Parallel()
+
+	errFirst := errors.New("first")
+	errSecond := errors.New("second")
+
+	errGroup, ctx := WithContext(context.Background(), WithCollectAll())
+
+	errGroup.Go(func() error {
+		return errFirst
+	})
+	errGroup.Go(func() error {
+		return errSecond
+	})
+	errGroup.Go(func() error {
+		return nil
+	})
+
+	err := errGroup.Wait()
+	require.ErrorIs(t, err, errFirst)
+	require.ErrorIs(t, err, errSecond)
+	require.Error(t, ctx.Err())
+}
+
+func TestErrorGroupPanic(t *testing.T) {
+	t.Parallel()
+
+	errGroup := New()
+
+	errGroup.Go(func() error {
+		panic("boom")
+	})
+
+	err := errGroup.Wait()
+
+	var panicErr *PanicError
+	require.ErrorAs(t, err, &panicErr)
+	assert.Equal(t, "boom", panicErr.Recovered)
+	assert.NotEmpty(t, panicErr.Stack)
+}
@@ -10,11 +10,13 @@ import (
 
 // MetricsQueryTracer реализует middleware для sql запросов к БД для сбора метрик.
 type MetricsQueryTracer struct {
+	pool string
 }
 
 // NewMetricsQueryTracer создает новый экземпляр MetricsQueryTracer.
-func NewMetricsQueryTracer() *MetricsQueryTracer {
-	return &MetricsQueryTracer{}
+// pool - имя пула соединений (роль реплики, номер шарда), которым помечаются метрики.
+func NewMetricsQueryTracer(pool string) *MetricsQueryTracer {
+	return &MetricsQueryTracer{pool: pool}
 }
 
 type ctxKey string
@@ -54,6 +56,6 @@ func (t *MetricsQueryTracer) TraceQueryEnd(
 		category = metrics.Insert
 	}
 
-	metrics.IncDBRequestCount(category)
-	metrics.AddDBRequestDurationHist(category, data.Err, elapsed)
+	metrics.IncDBRequestCount(t.pool, category)
+	metrics.AddDBRequestDurationHist(t.pool, category, data.Err, elapsed)
 }
@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// PoolRoleKey атрибут спана с ролью пула соединений (master/replica).
+	PoolRoleKey = attribute.Key("db.pool.role")
+	// ShardIndexKey атрибут спана с индексом шарда.
+	ShardIndexKey = attribute.Key("db.shard.index")
+	// RowsAffectedKey атрибут спана с количеством затронутых запросом строк.
+	RowsAffectedKey = attribute.Key("db.response.rows_affected")
+)
+
+const spanKey ctxKey = "querySpan"
+
+// QueryTracer реализует middleware для sql запросов к БД, которое собирает метрики
+// и создает дочерний спан на каждый запрос.
+type QueryTracer struct {
+	metrics *MetricsQueryTracer
+	tracer  trace.Tracer
+	attrs   []attribute.KeyValue
+}
+
+// NewQueryTracer создает новый экземпляр QueryTracer.
+// pool - имя пула соединений для метрик и спанов, attrs - дополнительные атрибуты спанов
+// (например, роль пула или индекс шарда).
+func NewQueryTracer(tracer trace.Tracer, pool string, attrs ...attribute.KeyValue) *QueryTracer {
+	spanAttrs := make([]attribute.KeyValue, 0, len(attrs)+2)
+	spanAttrs = append(spanAttrs,
+		semconv.DBSystemNamePostgreSQL,
+		semconv.DBClientConnectionPoolName(pool),
+	)
+	spanAttrs = append(spanAttrs, attrs...)
+
+	return &QueryTracer{
+		metrics: NewMetricsQueryTracer(pool),
+		tracer:  tracer,
+		attrs:   spanAttrs,
+	}
+}
+
+func (t *QueryTracer) TraceQueryStart(
+	ctx context.Context,
+	conn *pgx.Conn,
+	data pgx.TraceQueryStartData,
+) context.Context {
+	ctx = t.metrics.TraceQueryStart(ctx, conn, data)
+
+	// Спаны создаются только внутри уже начатого трейса, чтобы фоновые запросы не порождали отдельные трейсы.
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+
+	name, operation := statementName(data.SQL)
+
+	attrs := make([]attribute.KeyValue, 0, len(t.attrs)+2)
+	attrs = append(attrs, t.attrs...)
+	attrs = append(attrs,
+		semconv.DBOperationName(operation),
+		semconv.DBQueryText(data.SQL),
+	)
+
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	return context.WithValue(ctx, spanKey, span)
+}
+
+func (t *QueryTracer) TraceQueryEnd(
+	ctx context.Context,
+	conn *pgx.Conn,
+	data pgx.TraceQueryEndData,
+) {
+	t.metrics.TraceQueryEnd(ctx, conn, data)
+
+	span, ok := ctx.Value(spanKey).(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	span.SetAttributes(RowsAffectedKey.Int64(data.CommandTag.RowsAffected()))
+
+	if data.Err != nil {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	}
+}
+
+// statementName возвращает имя запроса из комментария sqlc ("-- name: GetOrder :one")
+// и тип операции. Если комментария нет, имя совпадает с типом операции.
+func statementName(sql string) (name, operation string) {
+	sql = strings.TrimSpace(sql)
+
+	if rest, ok := strings.CutPrefix(sql, "-- name:"); ok {
+		line, query, _ := strings.Cut(rest, "\n")
+		if fields := strings.Fields(line); len(fields) > 0 {
+			name = fields[0]
+		}
+		sql = strings.TrimSpace(query)
+	}
+
+	if fields := strings.Fields(sql); len(fields) > 0 {
+		operation = strings.ToUpper(fields[0])
+	}
+
+	if name == "" {
+		name = operation
+	}
+
+	return name, operation
+}
@@ -0,0 +1,122 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestStatementName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		sql           string
+		wantName      string
+		wantOperation string
+	}{
+		{
+			name:          "sqlc query",
+			sql:           "-- name: GetOrderByID :one\nselect * from orders where order_id = $1",
+			wantName:      "GetOrderByID",
+			wantOperation: "SELECT",
+		},
+		{
+			name:          "plain query",
+			sql:           "  update stocks set reserved = $2 where sku = $1",
+			wantName:      "UPDATE",
+			wantOperation: "UPDATE",
+		},
+		{
+			name: "empty query",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, operation := statementName(tt.sql)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantOperation, operation)
+		})
+	}
+}
+
+func TestQueryTracer(t *testing.T) {
+	t.Parallel()
+
+	const sql = "-- name: InsertOrder :one\ninsert into orders(user_id) values ($1)"
+
+	t.Run("child span with pool attributes", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		tracer := provider.Tracer("test")
+
+		qt := NewQueryTracer(tracer, "master", PoolRoleKey.String("master"))
+
+		ctx, parent := tracer.Start(context.Background(), "request")
+		ctx = qt.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql})
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{CommandTag: pgconn.NewCommandTag("INSERT 0 1")})
+		parent.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+
+		span := spans[0]
+		assert.Equal(t, "InsertOrder", span.Name())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent().SpanID())
+
+		attrs := attribute.NewSet(span.Attributes()...)
+		role, _ := attrs.Value(PoolRoleKey)
+		assert.Equal(t, "master", role.AsString())
+		rows, _ := attrs.Value(RowsAffectedKey)
+		assert.Equal(t, int64(1), rows.AsInt64())
+		operation, _ := attrs.Value("db.operation.name")
+		assert.Equal(t, "INSERT", operation.AsString())
+	})
+
+	t.Run("error recorded", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		tracer := provider.Tracer("test")
+
+		qt := NewQueryTracer(tracer, "shard_0", ShardIndexKey.Int(0))
+
+		ctx, parent := tracer.Start(context.Background(), "request")
+		ctx = qt.TraceQueryStart(ctx, nil, pgx.TraceQueryStartData{SQL: sql})
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{Err: errors.New("duplicate key")})
+		parent.End()
+
+		spans := recorder.Ended()
+		require.Len(t, spans, 2)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+
+	t.Run("no span without parent trace", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		qt := NewQueryTracer(provider.Tracer("test"), "replica_0", PoolRoleKey.String("replica"))
+
+		ctx := qt.TraceQueryStart(context.Background(), nil, pgx.TraceQueryStartData{SQL: sql})
+		qt.TraceQueryEnd(ctx, nil, pgx.TraceQueryEndData{})
+
+		assert.Empty(t, recorder.Ended())
+	})
+}
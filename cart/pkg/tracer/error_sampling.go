@@ -0,0 +1,75 @@
+package tracer
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	t "go.opentelemetry.io/otel/trace"
+)
+
+// recordingSampler записывает спаны, не попавшие в выборку, вместо того чтобы их отбрасывать.
+// Такие спаны не экспортируются, но errorSpanProcessor может отправить их, если они завершились ошибкой.
+type recordingSampler struct {
+	base trace.Sampler
+}
+
+func newRecordingSampler(base trace.Sampler) trace.Sampler {
+	return &recordingSampler{base: base}
+}
+
+func (s *recordingSampler) ShouldSample(p trace.SamplingParameters) trace.SamplingResult {
+	res := s.base.ShouldSample(p)
+	if res.Decision == trace.Drop {
+		res.Decision = trace.RecordOnly
+	}
+
+	return res
+}
+
+func (s *recordingSampler) Description() string {
+	return fmt.Sprintf("RecordingSampler{%s}", s.base.Description())
+}
+
+// errorSpanProcessor передает дальше спаны из выборки и спаны, завершившиеся ошибкой.
+type errorSpanProcessor struct {
+	next trace.SpanProcessor
+}
+
+func newErrorSpanProcessor(next trace.SpanProcessor) trace.SpanProcessor {
+	return &errorSpanProcessor{next: next}
+}
+
+func (p *errorSpanProcessor) OnStart(parent context.Context, s trace.ReadWriteSpan) {
+	p.next.OnStart(parent, s)
+}
+
+func (p *errorSpanProcessor) OnEnd(s trace.ReadOnlySpan) {
+	if s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+
+	if s.Status().Code == codes.Error {
+		p.next.OnEnd(sampledSpan{ReadOnlySpan: s})
+	}
+}
+
+func (p *errorSpanProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *errorSpanProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// sampledSpan помечает записанный спан как попавший в выборку, чтобы экспортер его не отбросил.
+type sampledSpan struct {
+	trace.ReadOnlySpan
+}
+
+func (s sampledSpan) SpanContext() t.SpanContext {
+	sc := s.ReadOnlySpan.SpanContext()
+	return sc.WithTraceFlags(sc.TraceFlags().WithSampled(true))
+}
@@ -0,0 +1,86 @@
+package tracer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestErrorSampling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		cfg          Config
+		withError    bool
+		wantExported bool
+	}{
+		{
+			name:         "sampled span exported",
+			cfg:          Config{SampleRatio: 1},
+			wantExported: true,
+		},
+		{
+			name: "unsampled span dropped",
+			cfg:  Config{SampleRatio: 0},
+		},
+		{
+			name:      "unsampled error span dropped without SampleErrors",
+			cfg:       Config{SampleRatio: 0},
+			withError: true,
+		},
+		{
+			name:         "unsampled error span exported with SampleErrors",
+			cfg:          Config{SampleRatio: 0, SampleErrors: true},
+			withError:    true,
+			wantExported: true,
+		},
+		{
+			name: "unsampled ok span dropped with SampleErrors",
+			cfg:  Config{SampleRatio: 0, SampleErrors: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			exporter := tracetest.NewInMemoryExporter()
+
+			var processor trace.SpanProcessor = trace.NewSimpleSpanProcessor(exporter)
+			if tt.cfg.SampleErrors {
+				processor = newErrorSpanProcessor(processor)
+			}
+
+			provider := trace.NewTracerProvider(
+				trace.WithSampler(newSampler(tt.cfg)),
+				trace.WithSpanProcessor(processor),
+			)
+
+			defer func() {
+				require.NoError(t, provider.Shutdown(context.Background()))
+			}()
+
+			_, span := provider.Tracer("test").Start(context.Background(), "op")
+			if tt.withError {
+				span.SetStatus(codes.Error, "failed")
+			}
+			span.End()
+
+			spans := exporter.GetSpans()
+			if !tt.wantExported {
+				assert.Empty(t, spans)
+				return
+			}
+
+			require.Len(t, spans, 1)
+			assert.Equal(t, "op", spans[0].Name)
+			assert.True(t, spans[0].SpanContext.IsSampled())
+		})
+	}
+}
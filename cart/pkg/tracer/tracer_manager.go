@@ -2,9 +2,12 @@ package tracer
 
 import (
 	"context"
+	"fmt"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -12,6 +15,38 @@ import (
 	t "go.opentelemetry.io/otel/trace"
 )
 
+// Exporter определяет, куда отправляются спаны.
+type Exporter = string
+
+const (
+	// ExporterOTLPHTTP отправляет спаны по OTLP через HTTP.
+	ExporterOTLPHTTP Exporter = "otlp_http"
+	// ExporterOTLPGRPC отправляет спаны по OTLP через gRPC.
+	ExporterOTLPGRPC Exporter = "otlp_grpc"
+	// ExporterStdout выводит спаны в stdout.
+	ExporterStdout Exporter = "stdout"
+	// ExporterNone отключает экспорт спанов.
+	ExporterNone Exporter = "none"
+)
+
+// Config настройки трассировки.
+type Config struct {
+	ServiceName string
+	Environment string
+
+	// Exporter тип экспортера, по умолчанию ExporterOTLPHTTP.
+	Exporter Exporter
+	// Endpoint адрес коллектора для OTLP-экспортеров, например http://jaeger:4318.
+	Endpoint string
+
+	// SampleRatio доля трейсов, попадающих в выборку, от 0 до 1.
+	SampleRatio float64
+	// ParentBased учитывает решение о выборке, принятое вызывающим сервисом.
+	ParentBased bool
+	// SampleErrors экспортирует спаны с ошибкой, даже если трейс не попал в выборку.
+	SampleErrors bool
+}
+
 // Manager управляет трассировщиком и провайдером трассировки.
 type Manager struct {
 	Tracer   t.Tracer
@@ -19,41 +54,80 @@ type Manager struct {
 }
 
 // NewTracerManager создает новый экземпляр Manager для трассировки.
-func NewTracerManager(ctx context.Context, jaegerURL, serviceName, enviroment string) (*Manager, error) {
-	exp, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(jaegerURL))
-	if err != nil {
-		return nil, err
-	}
+func NewTracerManager(ctx context.Context, cfg Config) (*Manager, error) {
 	r, err := resource.Merge(
 		resource.Default(),
 		resource.NewWithAttributes(
 			semconv.SchemaURL,
-			semconv.ServiceName(serviceName),
-			semconv.DeploymentEnvironmentName(enviroment),
+			semconv.ServiceName(cfg.ServiceName),
+			semconv.DeploymentEnvironmentName(cfg.Environment),
 		),
 	)
 	if err != nil {
 		return nil, err
 	}
-	tracerProvider := trace.NewTracerProvider(
-		trace.WithBatcher(exp),
+
+	opts := []trace.TracerProviderOption{
 		trace.WithResource(r),
-	)
+		trace.WithSampler(newSampler(cfg)),
+	}
+
+	exp, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exp != nil {
+		var processor trace.SpanProcessor = trace.NewBatchSpanProcessor(exp)
+		if cfg.SampleErrors {
+			processor = newErrorSpanProcessor(processor)
+		}
+		opts = append(opts, trace.WithSpanProcessor(processor))
+	}
+
+	tracerProvider := trace.NewTracerProvider(opts...)
 
 	otel.SetTracerProvider(tracerProvider)
 	otel.SetTextMapPropagator(
 		propagation.NewCompositeTextMapPropagator(
 			propagation.TraceContext{},
+			propagation.Baggage{},
 		),
 	)
 
-	tracer := otel.GetTracerProvider().Tracer(serviceName)
+	tracer := otel.GetTracerProvider().Tracer(cfg.ServiceName)
 	return &Manager{
 		Tracer:   tracer,
 		provider: tracerProvider,
 	}, nil
 }
 
+func newExporter(ctx context.Context, cfg Config) (trace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterOTLPHTTP, "":
+		return otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(cfg.Endpoint))
+	case ExporterOTLPGRPC:
+		return otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(cfg.Endpoint))
+	case ExporterStdout:
+		return stdouttrace.New()
+	case ExporterNone:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("неизвестный тип экспортера трейсов: %s", cfg.Exporter)
+	}
+}
+
+func newSampler(cfg Config) trace.Sampler {
+	sampler := trace.TraceIDRatioBased(cfg.SampleRatio)
+	if cfg.ParentBased {
+		sampler = trace.ParentBased(sampler)
+	}
+	if cfg.SampleErrors {
+		sampler = newRecordingSampler(sampler)
+	}
+
+	return sampler
+}
+
 // Stop завершает работу провайдера трассировки.
 func (t *Manager) Stop(ctx context.Context) error {
 	return t.provider.Shutdown(ctx)
@@ -15,11 +15,15 @@ import (
 
 	"route256/cart/pkg/logger"
 	"route256/cart/pkg/myerrgroup"
+	postgrespkg "route256/cart/pkg/postgres"
+	"route256/cart/pkg/tracer"
 	"route256/loms/pkg/grpc/interceptor"
 	"route256/loms/pkg/http/middleware"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
@@ -27,9 +31,10 @@ import (
 
 // App создает компоненты для сервиса comments
 type App struct {
-	Config       *config.Config
-	grpcServer   *grpc.Server
-	grpcGWServer *http.Server
+	Config        *config.Config
+	grpcServer    *grpc.Server
+	grpcGWServer  *http.Server
+	tracerManager *tracer.Manager
 }
 
 // NewApp конструктор главного приложения.
@@ -40,10 +45,25 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	}
 
 	app := &App{Config: c}
+	tracingConfig := app.Config.Server.Tracing
+	app.tracerManager, err = tracer.NewTracerManager(ctx, tracer.Config{
+		ServiceName:  tracingConfig.ServiceName,
+		Environment:  tracingConfig.Environment,
+		Exporter:     tracingConfig.Exporter,
+		Endpoint:     fmt.Sprintf("http://%s:%s", app.Config.Jaeger.Host, app.Config.Jaeger.Port),
+		SampleRatio:  *tracingConfig.SampleRatio,
+		ParentBased:  tracingConfig.ParentBased,
+		SampleErrors: tracingConfig.SampleErrors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tracer.NewTracerManager: %w", err)
+	}
 
 	app.grpcServer = grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			interceptor.NewTracing(app.tracerManager).Do,
 			interceptor.Logging,
+			interceptor.Metrics,
 			interceptor.Validate,
 		),
 	)
@@ -51,11 +71,12 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	reflection.Register(app.grpcServer)
 
 	shards := make([]*postgres.Shard, 0, len(app.Config.DB.Shards))
-	for _, shardConfig := range app.Config.DB.Shards {
+	for i, shardConfig := range app.Config.DB.Shards {
 		postgresDSN := dsnBuilder(shardConfig.User, shardConfig.Password, shardConfig.Host,
 			shardConfig.Port, shardConfig.DBName)
 
-		shardPool, poolErr := newPool(ctx, postgresDSN)
+		shardPool, poolErr := newPool(ctx, postgresDSN, postgrespkg.NewQueryTracer(
+			app.tracerManager.Tracer, fmt.Sprintf("shard_%d", i), postgrespkg.ShardIndexKey.Int(i)))
 		if poolErr != nil {
 			return nil, fmt.Errorf("newPool: %w", poolErr)
 		}
@@ -91,12 +112,14 @@ func dsnBuilder(user, password, host string, port int64, dbname string) string {
 	return fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", user, password, host, port, dbname)
 }
 
-func newPool(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+func newPool(ctx context.Context, dsn string, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("pgxpool.ParseConfig (dsn=%s): %w", dsn, err)
 	}
 
+	config.ConnConfig.Tracer = tracer
+
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
 		return nil, fmt.Errorf("pgxpool.NewWithConfig (dsn=%s): %w", dsn, err)
@@ -136,6 +159,7 @@ func (a *App) ListenAndServeGRPCGateway(ctx context.Context) error {
 
 	mux := http.NewServeMux()
 	mux.Handle("/", gwMux)
+	mux.Handle("/metrics", promhttp.Handler())
 
 	handler := middleware.CORSAllPass(mux)
 
@@ -168,6 +192,9 @@ func (a *App) ListenAndServeGRPCGateway(ctx context.Context) error {
 // Shutdown gracefully останавливает приложение.
 func (a *App) Shutdown(ctx context.Context) error {
 	errGroup, ctx := myerrgroup.WithContext(ctx)
+	errGroup.Go(func() error {
+		return a.tracerManager.Stop(ctx)
+	})
 
 	errGroup.Go(func() error {
 		return a.grpcGWServer.Shutdown(ctx)
@@ -12,6 +12,7 @@ type Config struct {
 	Server CommentsServiceConfig `yaml:"service"`
 	App    AppConfig             `yaml:"app"`
 	DB     DBConfig              `yaml:"db"`
+	Jaeger JaegerConfig          `yaml:"jaeger"`
 }
 
 // CommentsServiceConfig конфиг для сервиса comments.
@@ -21,6 +22,7 @@ type CommentsServiceConfig struct {
 	HTTPPort                string            `yaml:"http_port"`
 	GRPCGateWay             GRPCGateWayConfig `yaml:"grpc_gateway"`
 	GracefulShutdownTimeout string            `yaml:"graceful_shutdown_timeout"`
+	Tracing                 TracingConfig     `yaml:"tracing"`
 }
 
 // AppConfig конфиг для настроек приложения.
@@ -36,6 +38,25 @@ type GRPCGateWayConfig struct {
 	IdleTimeout       string `yaml:"idle_timeout"`
 }
 
+// JaegerConfig конфиг для jaeger.
+type JaegerConfig struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+}
+
+// defaultSampleRatio доля трейсов в выборке, если sample_ratio не задан.
+const defaultSampleRatio = 1.0
+
+// TracingConfig конфиг для трассировки.
+type TracingConfig struct {
+	ServiceName  string   `yaml:"service_name"`
+	Environment  string   `yaml:"environment"`
+	Exporter     string   `yaml:"exporter"`
+	SampleRatio  *float64 `yaml:"sample_ratio"`
+	ParentBased  bool     `yaml:"parent_based"`
+	SampleErrors bool     `yaml:"sample_errors"`
+}
+
 // DBConfig конфиг для БД
 type DBConfig struct {
 	Buckets int64           `yaml:"buckets"`
@@ -65,5 +86,24 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("ошибка при декодировании yaml файла-конфига: %w", err)
 	}
 
+	if err := config.Server.Tracing.setDefaults(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
+
+// setDefaults задает значения по умолчанию и проверяет настройки трассировки.
+func (c *TracingConfig) setDefaults() error {
+	if c.SampleRatio == nil {
+		sampleRatio := defaultSampleRatio
+		c.SampleRatio = &sampleRatio
+		return nil
+	}
+
+	if *c.SampleRatio < 0 || *c.SampleRatio > 1 {
+		return fmt.Errorf("sample_ratio должен быть от 0 до 1, получено %v", *c.SampleRatio)
+	}
+
+	return nil
+}
@@ -14,6 +14,9 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// maxShardQueries ограничивает количество одновременных запросов к шардам.
+const maxShardQueries = 8
+
 // NewCommentRepository создает новый OrderRepository.
 func NewCommentRepository(shardManager *ShardManager) *CommentRepository {
 	return &CommentRepository{
@@ -150,7 +153,8 @@ func (c *CommentRepository) GetListByUser(ctx context.Context, userID int64) ([]
 
 	commentsDBList := make([][]*sqlcrepos.Comment, len(pools))
 
-	errgroup := myerrgroup.New()
+	errgroup := myerrgroup.New(myerrgroup.WithCollectAll())
+	errgroup.SetLimit(maxShardQueries)
 	for i, pool := range pools {
 		errgroup.Go(func() error {
 			querier := getQuerier(pool)
@@ -162,7 +166,7 @@ func (c *CommentRepository) GetListByUser(ctx context.Context, userID int64) ([]
 					return nil
 				}
 
-				return fmt.Errorf("querier.GetCommentsByUser: %w", err)
+				return fmt.Errorf("shard %d: querier.GetCommentsByUser: %w", i, err)
 			}
 
 			commentsDBList[i] = commentsDB
@@ -14,7 +14,6 @@ import (
 	"route256/cart/pkg/tracer"
 	"route256/loms/internal/handler"
 	"route256/loms/internal/infra/config"
-	"route256/loms/internal/infra/grpc/interceptor"
 	"route256/loms/internal/infra/kafka"
 	"route256/loms/internal/infra/repository/postgres"
 	"route256/loms/internal/service"
@@ -24,6 +23,7 @@ import (
 	"route256/loms/pkg/http/middleware"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	_ "github.com/lib/pq" // Import postgres driver
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -48,12 +48,16 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	}
 
 	app := &App{Config: c}
-	app.tracerManager, err = tracer.NewTracerManager(
-		ctx,
-		fmt.Sprintf("http://%s:%s", app.Config.Jaeger.Host, app.Config.Jaeger.Port),
-		app.Config.Server.Tracing.ServiceName,
-		app.Config.Server.Tracing.Environment,
-	)
+	tracingConfig := app.Config.Server.Tracing
+	app.tracerManager, err = tracer.NewTracerManager(ctx, tracer.Config{
+		ServiceName:  tracingConfig.ServiceName,
+		Environment:  tracingConfig.Environment,
+		Exporter:     tracingConfig.Exporter,
+		Endpoint:     fmt.Sprintf("http://%s:%s", app.Config.Jaeger.Host, app.Config.Jaeger.Port),
+		SampleRatio:  *tracingConfig.SampleRatio,
+		ParentBased:  tracingConfig.ParentBased,
+		SampleErrors: tracingConfig.SampleErrors,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("tracer.NewTracerManager: %w", err)
 	}
@@ -62,7 +66,7 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 		grpc.ChainUnaryInterceptor(
 			interceptorpkg.NewTracing(app.tracerManager).Do,
 			interceptorpkg.Logging,
-			interceptor.Metrics,
+			interceptorpkg.Metrics,
 			interceptorpkg.Validate,
 		),
 	)
@@ -75,14 +79,16 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	postgresReplicaDSN := dsnBuilder(app.Config.ReplicaDB.User, app.Config.ReplicaDB.Password,
 		app.Config.ReplicaDB.Host, app.Config.ReplicaDB.Port, app.Config.ReplicaDB.DBName)
 
-	masterPool, err := newPool(ctx, postgresMasterDSN)
+	masterPool, err := newPool(ctx, postgresMasterDSN, postgrespkg.NewQueryTracer(
+		app.tracerManager.Tracer, "master", postgrespkg.PoolRoleKey.String("master")))
 	if err != nil {
 		return nil, fmt.Errorf("newPool: %w", err)
 	}
 
 	replicaPools := []*pgxpool.Pool{}
-	for _, dsn := range []string{postgresReplicaDSN} {
-		pool, errPool := newPool(ctx, dsn)
+	for i, dsn := range []string{postgresReplicaDSN} {
+		pool, errPool := newPool(ctx, dsn, postgrespkg.NewQueryTracer(
+			app.tracerManager.Tracer, fmt.Sprintf("replica_%d", i), postgrespkg.PoolRoleKey.String("replica")))
 		if errPool != nil {
 			return nil, fmt.Errorf("newPool: %w", errPool)
 		}
@@ -106,7 +112,7 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	stocks.RegisterStockServiceV1Server(app.grpcServer, stocksHandler)
 	orders.RegisterOrderServiceV1Server(app.grpcServer, ordersHandler)
 
-	orderEventPubKafka, err := kafka.NewOrderEventTopicKafka([]string{app.Config.Kafka.Brokers}, app.Config.Kafka.OrderTopic)
+	orderEventPubKafka, err := kafka.NewOrderEventTopicKafka([]string{app.Config.Kafka.Brokers}, app.Config.Kafka.OrderTopic, app.tracerManager)
 	if err != nil {
 		return nil, fmt.Errorf("kafka.NewOrderEventTopicKafka: %w", err)
 	}
@@ -122,13 +128,13 @@ func dsnBuilder(user, password, host string, port int64, dbname string) string {
 	return fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", user, password, host, port, dbname)
 }
 
-func newPool(ctx context.Context, dsn string) (*pgxpool.Pool, error) {
+func newPool(ctx context.Context, dsn string, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
 	config, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, fmt.Errorf("pgxpool.ParseConfig (dsn=%s): %w", dsn, err)
 	}
 
-	config.ConnConfig.Tracer = postgrespkg.NewMetricsQueryTracer()
+	config.ConnConfig.Tracer = tracer
 
 	pool, err := pgxpool.NewWithConfig(ctx, config)
 	if err != nil {
@@ -14,6 +14,21 @@ type Order struct {
 	UserID  int64
 	Status  Status
 	Items   []*OrderItem
+	// Discount скидка по промокоду, примененная к заказу в корзине.
+	Discount OrderDiscount
+}
+
+// OrderDiscount хранит данные о скидке на заказ.
+// Amount задается в минимальных единицах валюты Currency.
+type OrderDiscount struct {
+	PromoCode string
+	Amount    int64
+	Currency  string
+}
+
+// IsZero возвращает true, если к заказу не применялась скидка.
+func (d OrderDiscount) IsZero() bool {
+	return d.PromoCode == "" && d.Amount == 0
 }
 
 // Status тип для статуса заказа.
@@ -4,15 +4,19 @@ import "time"
 
 // OrderEvent описывает событие заказа.
 type OrderEvent struct {
+	EventID int64
 	OrderID int64
 	Status  string
 	Moment  string
+	// TraceContext контекст трассировки операции, породившей событие.
+	TraceContext map[string]string
 }
 
 // OrderEventOutbox описывает событие заказа в outbox.
 type OrderEventOutbox struct {
-	ID          int64
-	OrderID     int64
-	OrderStatus string
-	Moment      time.Time
+	ID           int64
+	OrderID      int64
+	OrderStatus  string
+	Moment       time.Time
+	TraceContext map[string]string
 }
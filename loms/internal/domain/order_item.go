@@ -4,4 +4,7 @@ package domain
 type OrderItem struct {
 	SkuID int64
 	Count uint32
+	// Price цена единицы товара на момент оформления заказа в минимальных единицах валюты Currency.
+	Price    int64
+	Currency string
 }
@@ -40,11 +40,18 @@ func (os *OrderServerGRPC) OrderCreateV1(ctx context.Context, req *orders.OrderC
 	order := &domain.Order{
 		UserID: req.UserId,
 		Items:  make([]*domain.OrderItem, 0, len(req.Items)),
+		Discount: domain.OrderDiscount{
+			PromoCode: req.GetDiscount().GetPromoCode(),
+			Amount:    req.GetDiscount().GetAmount().GetUnits(),
+			Currency:  req.GetDiscount().GetAmount().GetCurrency(),
+		},
 	}
 	for _, reqItem := range req.Items {
 		order.Items = append(order.Items, &domain.OrderItem{
-			SkuID: reqItem.SkuId,
-			Count: reqItem.Count,
+			SkuID:    reqItem.SkuId,
+			Count:    reqItem.Count,
+			Price:    reqItem.GetPrice().GetUnits(),
+			Currency: reqItem.GetPrice().GetCurrency(),
 		})
 	}
 
@@ -83,10 +90,26 @@ func (os *OrderServerGRPC) OrderInfoV1(ctx context.Context, req *orders.OrderInf
 		Items:  make([]*orders.ItemInfo, 0, len(order.Items)),
 	}
 	for _, item := range order.Items {
-		res.Items = append(res.Items, &orders.ItemInfo{
+		itemInfo := &orders.ItemInfo{
 			SkuId: item.SkuID,
 			Count: item.Count,
-		})
+		}
+		if item.Currency != "" {
+			itemInfo.Price = &orders.Money{
+				Units:    item.Price,
+				Currency: item.Currency,
+			}
+		}
+		res.Items = append(res.Items, itemInfo)
+	}
+	if !order.Discount.IsZero() {
+		res.Discount = &orders.OrderDiscount{
+			PromoCode: order.Discount.PromoCode,
+			Amount: &orders.Money{
+				Units:    order.Discount.Amount,
+				Currency: order.Discount.Currency,
+			},
+		}
 	}
 
 	return res, nil
@@ -66,6 +66,37 @@ func TestOrderServerGRPC_OrderCreate(t *testing.T) {
 		assert.Equal(t, orderID, res.OrderId)
 	})
 
+	t.Run("create order with discount", func(t *testing.T) {
+		t.Parallel()
+		tc := newTestComponentOH(t)
+
+		req := &orders.OrderCreateRequest{
+			UserId: 42,
+			Items: []*orders.ItemInfo{
+				{SkuId: 1001, Count: 2, Price: &orders.Money{Units: 750, Currency: "RUB"}},
+			},
+			Discount: &orders.OrderDiscount{
+				PromoCode: "SALE10",
+				Amount:    &orders.Money{Units: 150, Currency: "RUB"},
+			},
+		}
+
+		expectedOrder := &domain.Order{
+			UserID: 42,
+			Items: []*domain.OrderItem{
+				{SkuID: 1001, Count: 2, Price: 750, Currency: "RUB"},
+			},
+			Discount: domain.OrderDiscount{PromoCode: "SALE10", Amount: 150, Currency: "RUB"},
+		}
+
+		tc.orderServMock.CreateMock.When(context.Background(), expectedOrder).
+			Then(int64(778), nil)
+
+		res, err := tc.orderHandler.OrderCreateV1(context.Background(), req)
+		require.NoError(t, err)
+		assert.Equal(t, int64(778), res.OrderId)
+	})
+
 	t.Run("create order failed: can not reserve item", func(t *testing.T) {
 		t.Parallel()
 		tc := newTestComponentOH(t)
@@ -111,6 +142,36 @@ func TestOrderServerGRPC_OrderInfo(t *testing.T) {
 		assert.EqualValues(t, domain.AwaitingPayment, res.Status)
 		require.Len(t, res.Items, 1)
 		assert.Equal(t, int64(1001), res.Items[0].SkuId)
+		assert.Nil(t, res.Items[0].Price)
+		assert.Nil(t, res.Discount)
+	})
+
+	t.Run("order info with discount", func(t *testing.T) {
+		t.Parallel()
+		tc := newTestComponentOH(t)
+
+		req := &orders.OrderInfoRequest{OrderId: 124}
+		expectedOrder := &domain.Order{
+			UserID: 50,
+			Status: domain.AwaitingPayment,
+			Items: []*domain.OrderItem{
+				{SkuID: 1001, Count: 3, Price: 750, Currency: "RUB"},
+			},
+			Discount: domain.OrderDiscount{PromoCode: "SALE10", Amount: 150, Currency: "RUB"},
+		}
+
+		tc.orderServMock.GetInfoByIDMock.Return(expectedOrder, nil)
+
+		res, err := tc.orderHandler.OrderInfoV1(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		require.NotNil(t, res.Items[0].Price)
+		assert.Equal(t, int64(750), res.Items[0].Price.Units)
+		assert.Equal(t, "RUB", res.Items[0].Price.Currency)
+		require.NotNil(t, res.Discount)
+		assert.Equal(t, "SALE10", res.Discount.PromoCode)
+		assert.Equal(t, int64(150), res.Discount.Amount.Units)
+		assert.Equal(t, "RUB", res.Discount.Amount.Currency)
 	})
 
 	t.Run("order not found", func(t *testing.T) {
@@ -58,10 +58,17 @@ type JaegerConfig struct {
 	Port string `yaml:"port"`
 }
 
+// defaultSampleRatio доля трейсов в выборке, если sample_ratio не задан.
+const defaultSampleRatio = 1.0
+
 // TracingConfig конфиг для трассировки.
 type TracingConfig struct {
-	ServiceName string `yaml:"service_name"`
-	Environment string `yaml:"environment"`
+	ServiceName  string   `yaml:"service_name"`
+	Environment  string   `yaml:"environment"`
+	Exporter     string   `yaml:"exporter"`
+	SampleRatio  *float64 `yaml:"sample_ratio"`
+	ParentBased  bool     `yaml:"parent_based"`
+	SampleErrors bool     `yaml:"sample_errors"`
 }
 
 // KafkaConfig конфиг для kafka.
@@ -89,5 +96,24 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("ошибка при декодировании yaml файла-конфига: %w", err)
 	}
 
+	if err := config.Server.Tracing.setDefaults(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
+
+// setDefaults задает значения по умолчанию и проверяет настройки трассировки.
+func (c *TracingConfig) setDefaults() error {
+	if c.SampleRatio == nil {
+		sampleRatio := defaultSampleRatio
+		c.SampleRatio = &sampleRatio
+		return nil
+	}
+
+	if *c.SampleRatio < 0 || *c.SampleRatio > 1 {
+		return fmt.Errorf("sample_ratio должен быть от 0 до 1, получено %v", *c.SampleRatio)
+	}
+
+	return nil
+}
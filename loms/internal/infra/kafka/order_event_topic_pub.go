@@ -1,23 +1,32 @@
 package kafka
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"route256/cart/pkg/tracer"
 	"route256/loms/internal/domain"
 
 	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // OrderEventTopicKafka реализует публикацию событий заказов в Kafka.
 type OrderEventTopicKafka struct {
 	producer sarama.SyncProducer
 	topic    string
+	tm       *tracer.Manager
 }
 
 // NewOrderEventTopicKafka создает новый экземпляр OrderEventTopicKafka.
-func NewOrderEventTopicKafka(brokers []string, topic string) (*OrderEventTopicKafka, error) {
+func NewOrderEventTopicKafka(brokers []string, topic string, tm *tracer.Manager) (*OrderEventTopicKafka, error) {
 	o := &OrderEventTopicKafka{
 		topic: topic,
+		tm:    tm,
 	}
 
 	config := sarama.NewConfig()
@@ -35,8 +44,34 @@ func NewOrderEventTopicKafka(brokers []string, topic string) (*OrderEventTopicKa
 }
 
 // Send публикует сообщение в Kafka с заданным ключом и событием заказа.
+// Трейс продолжает контекст трассировки события и передается в заголовках W3C Trace Context.
 func (o *OrderEventTopicKafka) Send(key string, value *domain.OrderEvent) error {
+	propagator := otel.GetTextMapPropagator()
+	ctx := propagator.Extract(context.Background(), propagation.MapCarrier(value.TraceContext))
+
+	ctx, span := o.tm.Tracer.Start(ctx, fmt.Sprintf("send %s", o.topic),
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeSend,
+			semconv.MessagingDestinationName(o.topic),
+			semconv.MessagingKafkaMessageKey(key),
+		),
+	)
+	defer span.End()
+
+	err := o.send(ctx, key, value)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (o *OrderEventTopicKafka) send(ctx context.Context, key string, value *domain.OrderEvent) error {
 	valueBytes, err := json.Marshal(&OrderEventKafka{
+		EventID: value.EventID,
 		OrderID: value.OrderID,
 		Status:  value.Status,
 		Moment:  value.Moment,
@@ -45,10 +80,22 @@ func (o *OrderEventTopicKafka) Send(key string, value *domain.OrderEvent) error
 		return err
 	}
 
+	traceHeaders := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, traceHeaders)
+
+	headers := make([]sarama.RecordHeader, 0, len(traceHeaders))
+	for k, v := range traceHeaders {
+		headers = append(headers, sarama.RecordHeader{
+			Key:   []byte(k),
+			Value: []byte(v),
+		})
+	}
+
 	msg := &sarama.ProducerMessage{
-		Topic: o.topic,
-		Key:   sarama.StringEncoder(key),
-		Value: sarama.ByteEncoder(valueBytes),
+		Topic:   o.topic,
+		Key:     sarama.StringEncoder(key),
+		Value:   sarama.ByteEncoder(valueBytes),
+		Headers: headers,
 	}
 
 	_, _, err = o.producer.SendMessage(msg)
@@ -2,12 +2,16 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"route256/cart/pkg/logger"
 	"route256/loms/internal/domain"
 	sqlcrepos "route256/loms/internal/infra/repository/postgres/sqlc/generated"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 // NewOrderEventRepository создает новый OrderEventRepository.
@@ -23,12 +27,22 @@ type OrderEventRepository struct {
 }
 
 // Insert добавляет новое событие об изменении статуса заказа в outbox.
+// Вместе с событием сохраняется контекст трассировки из ctx.
 func (oe *OrderEventRepository) Insert(ctx context.Context, order *domain.Order) error {
-	err := oe.querier.InsertOrderEvent(ctx, &sqlcrepos.InsertOrderEventParams{
-		OrderID:     &order.OrderID,
-		OrderStatus: string(order.Status),
-		Moment:      now(),
-		EventStatus: string(domain.EventNew),
+	traceContext := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, traceContext)
+
+	traceContextBytes, err := json.Marshal(traceContext)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	err = oe.querier.InsertOrderEvent(ctx, &sqlcrepos.InsertOrderEventParams{
+		OrderID:      &order.OrderID,
+		OrderStatus:  string(order.Status),
+		Moment:       now(),
+		EventStatus:  string(domain.EventNew),
+		TraceContext: traceContextBytes,
 	})
 	if err != nil {
 		return fmt.Errorf("querier.InsertOrderEvent: %w", err)
@@ -53,11 +67,18 @@ func (oe *OrderEventRepository) GetUnprocessedEventsLimit(ctx context.Context, l
 
 	res := make([]*domain.OrderEventOutbox, 0, len(rows))
 	for _, row := range rows {
+		var traceContext map[string]string
+		err = json.Unmarshal(row.TraceContext, &traceContext)
+		if err != nil {
+			logger.WarnwCtx(ctx, fmt.Sprintf("json.Unmarshal trace_context (id=%d): %s", row.ID, err.Error()))
+		}
+
 		res = append(res, &domain.OrderEventOutbox{
-			ID:          row.ID,
-			OrderID:     *row.OrderID,
-			OrderStatus: row.OrderStatus,
-			Moment:      row.Moment.Time,
+			ID:           row.ID,
+			OrderID:      *row.OrderID,
+			OrderStatus:  row.OrderStatus,
+			Moment:       row.Moment.Time,
+			TraceContext: traceContext,
 		})
 	}
 
@@ -26,8 +26,11 @@ type OrderRepository struct {
 // Insert добавляет новый заказ и возвращает его ID из postgres.
 func (or *OrderRepository) Insert(ctx context.Context, order *domain.Order) (int64, error) {
 	orderID, err := or.querier.AddOrder(ctx, &sqlcrepos.AddOrderParams{
-		UserID: order.UserID,
-		Status: string(order.Status),
+		UserID:    order.UserID,
+		Status:    string(order.Status),
+		PromoCode:        order.Discount.PromoCode,
+		Discount:         order.Discount.Amount,
+		DiscountCurrency: order.Discount.Currency,
 	})
 	if err != nil {
 		return 0, fmt.Errorf("querier.AddOrder: %w", err)
@@ -35,9 +38,11 @@ func (or *OrderRepository) Insert(ctx context.Context, order *domain.Order) (int
 
 	for _, item := range order.Items {
 		err = or.querier.AddOrderItem(ctx, &sqlcrepos.AddOrderItemParams{
-			Sku:     item.SkuID,
-			OrderID: orderID,
-			Count:   int64(item.Count),
+			Sku:      item.SkuID,
+			OrderID:  orderID,
+			Count:    int64(item.Count),
+			Price:    item.Price,
+			Currency: item.Currency,
 		})
 		if err != nil {
 			return 0, fmt.Errorf("querier.AddOrderItem: %w", err)
@@ -68,6 +73,11 @@ func (or *OrderRepository) GetByIDOrderItemsBySKU(ctx context.Context, orderID i
 		UserID:  orderDB.UserID,
 		Status:  domain.Status(orderDB.Status),
 		Items:   make([]*domain.OrderItem, 0, len(orderItemsDB)),
+		Discount: domain.OrderDiscount{
+			PromoCode: orderDB.PromoCode,
+			Amount:    orderDB.Discount,
+			Currency:  orderDB.DiscountCurrency,
+		},
 	}
 	for _, itemDB := range orderItemsDB {
 		count, err := Int64ToUint32(itemDB.Count)
@@ -76,8 +86,10 @@ func (or *OrderRepository) GetByIDOrderItemsBySKU(ctx context.Context, orderID i
 		}
 
 		order.Items = append(order.Items, &domain.OrderItem{
-			SkuID: itemDB.Sku,
-			Count: count,
+			SkuID:    itemDB.Sku,
+			Count:    count,
+			Price:    itemDB.Price,
+			Currency: itemDB.Currency,
 		})
 	}
 
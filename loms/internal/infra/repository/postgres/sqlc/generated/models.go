@@ -5,9 +5,12 @@
 package repo_sqlc
 
 type Order struct {
-	OrderID int64
-	UserID  int64
-	Status  string
+	OrderID          int64
+	UserID           int64
+	Status           string
+	PromoCode        string
+	Discount         int64
+	DiscountCurrency string
 }
 
 type Stock struct {
@@ -12,36 +12,53 @@ import (
 )
 
 const addOrder = `-- name: AddOrder :one
-insert into orders(user_id, status)
-values ($1, $2)
+insert into orders(user_id, status, promo_code, discount, discount_currency)
+values ($1, $2, $3, $4, $5)
 returning order_id
 `
 
 type AddOrderParams struct {
-	UserID int64
-	Status string
+	UserID           int64
+	Status           string
+	PromoCode        string
+	Discount         int64
+	DiscountCurrency string
 }
 
 func (q *Queries) AddOrder(ctx context.Context, arg *AddOrderParams) (int64, error) {
-	row := q.db.QueryRow(ctx, addOrder, arg.UserID, arg.Status)
+	row := q.db.QueryRow(ctx, addOrder,
+		arg.UserID,
+		arg.Status,
+		arg.PromoCode,
+		arg.Discount,
+		arg.DiscountCurrency,
+	)
 	var order_id int64
 	err := row.Scan(&order_id)
 	return order_id, err
 }
 
 const addOrderItem = `-- name: AddOrderItem :exec
-insert into order_items(sku, order_id, count)
-values ($1, $2, $3)
+insert into order_items(sku, order_id, count, price, currency)
+values ($1, $2, $3, $4, $5)
 `
 
 type AddOrderItemParams struct {
-	Sku     int64
-	OrderID int64
-	Count   int64
+	Sku      int64
+	OrderID  int64
+	Count    int64
+	Price    int64
+	Currency string
 }
 
 func (q *Queries) AddOrderItem(ctx context.Context, arg *AddOrderItemParams) error {
-	_, err := q.db.Exec(ctx, addOrderItem, arg.Sku, arg.OrderID, arg.Count)
+	_, err := q.db.Exec(ctx, addOrderItem,
+		arg.Sku,
+		arg.OrderID,
+		arg.Count,
+		arg.Price,
+		arg.Currency,
+	)
 	return err
 }
 
@@ -66,7 +83,7 @@ func (q *Queries) AddStock(ctx context.Context, arg *AddStockParams) error {
 }
 
 const getOrderByID = `-- name: GetOrderByID :one
-select order_id, user_id, status
+select order_id, user_id, status, promo_code, discount, discount_currency
 from orders
 where order_id = $1
 `
@@ -74,21 +91,30 @@ where order_id = $1
 func (q *Queries) GetOrderByID(ctx context.Context, orderID int64) (*Order, error) {
 	row := q.db.QueryRow(ctx, getOrderByID, orderID)
 	var i Order
-	err := row.Scan(&i.OrderID, &i.UserID, &i.Status)
+	err := row.Scan(
+		&i.OrderID,
+		&i.UserID,
+		&i.Status,
+		&i.PromoCode,
+		&i.Discount,
+		&i.DiscountCurrency,
+	)
 	return &i, err
 }
 
 const getOrderItemsOrderBySKU = `-- name: GetOrderItemsOrderBySKU :many
-select sku, order_id, count
+select sku, order_id, count, price, currency
 from order_items
 where order_id = $1
 order by sku
 `
 
 type GetOrderItemsOrderBySKURow struct {
-	Sku     int64
-	OrderID int64
-	Count   int64
+	Sku      int64
+	OrderID  int64
+	Count    int64
+	Price    int64
+	Currency string
 }
 
 func (q *Queries) GetOrderItemsOrderBySKU(ctx context.Context, orderID int64) ([]*GetOrderItemsOrderBySKURow, error) {
@@ -100,7 +126,13 @@ func (q *Queries) GetOrderItemsOrderBySKU(ctx context.Context, orderID int64) ([
 	var items []*GetOrderItemsOrderBySKURow
 	for rows.Next() {
 		var i GetOrderItemsOrderBySKURow
-		if err := rows.Scan(&i.Sku, &i.OrderID, &i.Count); err != nil {
+		if err := rows.Scan(
+			&i.Sku,
+			&i.OrderID,
+			&i.Count,
+			&i.Price,
+			&i.Currency,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -139,7 +171,7 @@ func (q *Queries) GetStockBySKUForUpdate(ctx context.Context, sku int64) (*Stock
 }
 
 const getUnprocessedEventsLimit = `-- name: GetUnprocessedEventsLimit :many
-select id, order_id, order_status, moment
+select id, order_id, order_status, moment, trace_context
 from orders_event_outbox
 where event_status = 'new'
 order by moment
@@ -147,10 +179,11 @@ limit $1
 `
 
 type GetUnprocessedEventsLimitRow struct {
-	ID          int64
-	OrderID     *int64
-	OrderStatus string
-	Moment      pgtype.Timestamp
+	ID           int64
+	OrderID      *int64
+	OrderStatus  string
+	Moment       pgtype.Timestamp
+	TraceContext []byte
 }
 
 func (q *Queries) GetUnprocessedEventsLimit(ctx context.Context, limit int32) ([]*GetUnprocessedEventsLimitRow, error) {
@@ -167,6 +200,7 @@ func (q *Queries) GetUnprocessedEventsLimit(ctx context.Context, limit int32) ([
 			&i.OrderID,
 			&i.OrderStatus,
 			&i.Moment,
+			&i.TraceContext,
 		); err != nil {
 			return nil, err
 		}
@@ -179,15 +213,16 @@ func (q *Queries) GetUnprocessedEventsLimit(ctx context.Context, limit int32) ([
 }
 
 const insertOrderEvent = `-- name: InsertOrderEvent :exec
-insert into orders_event_outbox(order_id, order_status, moment, event_status)
-values ($1, $2, $3, $4)
+insert into orders_event_outbox(order_id, order_status, moment, event_status, trace_context)
+values ($1, $2, $3, $4, $5)
 `
 
 type InsertOrderEventParams struct {
-	OrderID     *int64
-	OrderStatus string
-	Moment      pgtype.Timestamp
-	EventStatus string
+	OrderID      *int64
+	OrderStatus  string
+	Moment       pgtype.Timestamp
+	EventStatus  string
+	TraceContext []byte
 }
 
 func (q *Queries) InsertOrderEvent(ctx context.Context, arg *InsertOrderEventParams) error {
@@ -196,6 +231,7 @@ func (q *Queries) InsertOrderEvent(ctx context.Context, arg *InsertOrderEventPar
 		arg.OrderStatus,
 		arg.Moment,
 		arg.EventStatus,
+		arg.TraceContext,
 	)
 	return err
 }
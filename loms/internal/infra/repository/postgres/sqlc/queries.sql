@@ -1,6 +1,6 @@
 -- name: AddOrder :one
-insert into orders(user_id, status)
-values ($1, $2)
+insert into orders(user_id, status, promo_code, discount, discount_currency)
+values ($1, $2, $3, $4, $5)
 returning order_id;
 
 -- name: GetOrderByID :one
@@ -16,11 +16,11 @@ where order_id = $1;
 
 
 -- name: AddOrderItem :exec
-insert into order_items(sku, order_id, count)
-values ($1, $2, $3);
+insert into order_items(sku, order_id, count, price, currency)
+values ($1, $2, $3, $4, $5);
 
 -- name: GetOrderItemsOrderBySKU :many
-select sku, order_id, count
+select sku, order_id, count, price, currency
 from order_items
 where order_id = $1
 order by sku;
@@ -65,11 +65,11 @@ for update;
 
 
 -- name: InsertOrderEvent :exec
-insert into orders_event_outbox(order_id, order_status, moment, event_status)
-values ($1, $2, $3, $4);
+insert into orders_event_outbox(order_id, order_status, moment, event_status, trace_context)
+values ($1, $2, $3, $4, $5);
 
 -- name: GetUnprocessedEventsLimit :many
-select id, order_id, order_status, moment
+select id, order_id, order_status, moment, trace_context
 from orders_event_outbox
 where event_status = 'new'
 order by moment
@@ -73,9 +73,11 @@ func (o *OrderEventPublisher) sendEvents(ctx context.Context) error {
 		}
 
 		msg := &domain.OrderEvent{
-			OrderID: event.OrderID,
-			Status:  event.OrderStatus,
-			Moment:  event.Moment.Format(time.RFC3339),
+			EventID:      event.ID,
+			OrderID:      event.OrderID,
+			Status:       event.OrderStatus,
+			Moment:       event.Moment.Format(time.RFC3339),
+			TraceContext: event.TraceContext,
 		}
 
 		innerErr := o.pub.Send(messageKey(msg), msg)
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders_event_outbox
+    ADD COLUMN trace_context JSONB NOT NULL DEFAULT '{}'::jsonb;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders_event_outbox
+    DROP COLUMN trace_context;
+-- +goose StatementEnd
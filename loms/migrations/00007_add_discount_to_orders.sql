@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN promo_code TEXT NOT NULL DEFAULT '',
+    ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN promo_code,
+    DROP COLUMN discount;
+-- +goose StatementEnd
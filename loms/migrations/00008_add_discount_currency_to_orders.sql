@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN discount_currency TEXT NOT NULL DEFAULT '';
+
+UPDATE orders
+SET discount_currency = 'RUB'
+WHERE discount > 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN discount_currency;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE order_items
+    ADD COLUMN price BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN currency TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_items
+    DROP COLUMN price,
+    DROP COLUMN currency;
+-- +goose StatementEnd
@@ -113,6 +113,35 @@ func (m *OrderCreateRequest) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderCreateRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderCreateRequestValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderCreateRequestValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderCreateRequestMultiError(errors)
 	}
@@ -237,6 +266,35 @@ func (m *ItemInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ItemInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ItemInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ItemInfoValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ItemInfoMultiError(errors)
 	}
@@ -314,6 +372,263 @@ var _ interface {
 	ErrorName() string
 } = ItemInfoValidationError{}
 
+// Validate checks the field values on OrderDiscount with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderDiscount) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderDiscount with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderDiscountMultiError, or
+// nil if none found.
+func (m *OrderDiscount) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderDiscount) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PromoCode
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderDiscountValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderDiscountValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderDiscountValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderDiscountMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderDiscountMultiError is an error wrapping multiple validation errors
+// returned by OrderDiscount.ValidateAll() if the designated constraints
+// aren't met.
+type OrderDiscountMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderDiscountMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderDiscountMultiError) AllErrors() []error { return m }
+
+// OrderDiscountValidationError is the validation error returned by
+// OrderDiscount.Validate if the designated constraints aren't met.
+type OrderDiscountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderDiscountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderDiscountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderDiscountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderDiscountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderDiscountValidationError) ErrorName() string {
+	return "OrderDiscountValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderDiscountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderDiscount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderDiscountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderDiscountValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUnits() < 0 {
+		err := MoneyValidationError{
+			field:  "Units",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Money_Currency_Pattern.MatchString(m.GetCurrency()) {
+		err := MoneyValidationError{
+			field:  "Currency",
+			reason: "value does not match regex pattern \"^[A-Z]{3}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string {
+	return "MoneyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+var _Money_Currency_Pattern = regexp.MustCompile("^[A-Z]{3}$")
+
 // Validate checks the field values on OrderCreateResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -589,6 +904,35 @@ func (m *OrderInfoResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderInfoResponseValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderInfoResponseValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderInfoResponseMultiError(errors)
 	}
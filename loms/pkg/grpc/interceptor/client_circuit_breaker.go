@@ -0,0 +1,50 @@
+package interceptor
+
+import (
+	"context"
+	"route256/cart/pkg/circuitbreaker"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClientCircuitBreaker отклоняет gRPC-вызовы без обращения к серверу, пока сервер недоступен.
+type ClientCircuitBreaker struct {
+	breaker *circuitbreaker.Breaker
+}
+
+// NewClientCircuitBreaker создает новый ClientCircuitBreaker.
+func NewClientCircuitBreaker(breaker *circuitbreaker.Breaker) *ClientCircuitBreaker {
+	return &ClientCircuitBreaker{breaker: breaker}
+}
+
+// Do выполняет вызов, если breaker замкнут. Иначе сразу возвращает Unavailable.
+func (c *ClientCircuitBreaker) Do(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if err := c.breaker.Allow(); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	c.breaker.Done(!isServerFailure(err))
+
+	return err
+}
+
+// isServerFailure проверяет, что ошибка вызвана недоступностью или перегрузкой сервера, а не бизнес-логикой,
+// ошибкой в обработке конкретного запроса или отменой вызова.
+func isServerFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
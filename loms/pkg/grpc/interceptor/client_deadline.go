@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ClientDeadline ограничивает время выполнения gRPC-вызова с клиентской стороны.
+type ClientDeadline struct {
+	timeout time.Duration
+}
+
+// NewClientDeadline создает новый ClientDeadline.
+// Если у контекста вызова уже есть более ранний дедлайн, используется он.
+func NewClientDeadline(timeout time.Duration) *ClientDeadline {
+	return &ClientDeadline{timeout: timeout}
+}
+
+// Do выполняет вызов с дедлайном.
+func (d *ClientDeadline) Do(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
@@ -0,0 +1,163 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"route256/cart/pkg/circuitbreaker"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeInvoker struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeInvoker) invoke(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+
+	err := f.errs[0]
+	if len(f.errs) > 1 {
+		f.errs = f.errs[1:]
+	}
+	return err
+}
+
+func TestClientRetry(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCode  codes.Code
+		wantCalls int
+	}{
+		{
+			name:      "success after retry",
+			errs:      []error{unavailable, nil},
+			wantCode:  codes.OK,
+			wantCalls: 2,
+		},
+		{
+			name:      "retries exhausted",
+			errs:      []error{unavailable},
+			wantCode:  codes.Unavailable,
+			wantCalls: 3,
+		},
+		{
+			name:      "non-transient code not retried",
+			errs:      []error{status.Error(codes.FailedPrecondition, "not enough stock")},
+			wantCode:  codes.FailedPrecondition,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			invoker := &fakeInvoker{errs: tt.errs}
+			err := NewClientRetry(2, time.Millisecond).Do(context.Background(), "/method", nil, nil, nil, invoker.invoke)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, invoker.calls)
+		})
+	}
+}
+
+func TestClientRetry_MethodRetryCodes(t *testing.T) {
+	t.Parallel()
+
+	retry := NewClientRetry(2, time.Millisecond,
+		WithMethodRetryCodes("/idempotent", codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted),
+	)
+
+	tests := []struct {
+		name      string
+		method    string
+		err       error
+		wantCalls int
+	}{
+		{name: "deadline exceeded retried for idempotent method", method: "/idempotent", err: status.Error(codes.DeadlineExceeded, "deadline"), wantCalls: 2},
+		{name: "resource exhausted retried for idempotent method", method: "/idempotent", err: status.Error(codes.ResourceExhausted, "exhausted"), wantCalls: 2},
+		{name: "aborted retried for idempotent method", method: "/idempotent", err: status.Error(codes.Aborted, "aborted"), wantCalls: 2},
+		{name: "deadline exceeded not retried for other methods", method: "/other", err: status.Error(codes.DeadlineExceeded, "deadline"), wantCalls: 1},
+		{name: "unavailable retried for other methods", method: "/other", err: status.Error(codes.Unavailable, "unavailable"), wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			invoker := &fakeInvoker{errs: []error{tt.err, nil}}
+			err := retry.Do(context.Background(), tt.method, nil, nil, nil, invoker.invoke)
+			if tt.wantCalls == 1 {
+				assert.Equal(t, status.Code(tt.err), status.Code(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantCalls, invoker.calls)
+		})
+	}
+}
+
+func TestClientDeadline(t *testing.T) {
+	t.Parallel()
+
+	var deadline time.Time
+	var ok bool
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		deadline, ok = ctx.Deadline()
+		return nil
+	}
+
+	start := time.Now()
+	err := NewClientDeadline(time.Second).Do(context.Background(), "/method", nil, nil, nil, invoker)
+	require.NoError(t, err)
+
+	require.True(t, ok)
+	assert.WithinDuration(t, start.Add(time.Second), deadline, 100*time.Millisecond)
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	breaker := circuitbreaker.New(t.Name(), circuitbreaker.Config{
+		FailureThreshold: 2,
+		OpenTimeout:      time.Minute,
+	})
+	cb := NewClientCircuitBreaker(breaker)
+
+	notFound := &fakeInvoker{errs: []error{status.Error(codes.NotFound, "not found")}}
+	for range 3 {
+		_ = cb.Do(context.Background(), "/method", nil, nil, nil, notFound.invoke)
+	}
+	require.Equal(t, circuitbreaker.Closed, breaker.State(), "business errors do not open breaker")
+
+	internal := &fakeInvoker{errs: []error{status.Error(codes.Internal, "internal")}}
+	for range 3 {
+		_ = cb.Do(context.Background(), "/method", nil, nil, nil, internal.invoke)
+	}
+	require.Equal(t, circuitbreaker.Closed, breaker.State(), "internal errors of single requests do not open breaker")
+
+	unavailable := &fakeInvoker{errs: []error{status.Error(codes.Unavailable, "unavailable")}}
+	for range 2 {
+		_ = cb.Do(context.Background(), "/method", nil, nil, nil, unavailable.invoke)
+	}
+	require.Equal(t, circuitbreaker.Open, breaker.State())
+
+	err := cb.Do(context.Background(), "/method", nil, nil, nil, unavailable.invoke)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, unavailable.calls, "open breaker fails fast")
+}
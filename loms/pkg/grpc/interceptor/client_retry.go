@@ -0,0 +1,91 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ClientRetry повторяет gRPC-вызовы, завершившиеся временной ошибкой.
+// По умолчанию повторяются только вызовы с кодом Unavailable: сервер не принял запрос,
+// поэтому повтор безопасен и для неидемпотентных методов.
+type ClientRetry struct {
+	maxRetries   int
+	backoff      time.Duration
+	methodCodes  map[string][]codes.Code
+	defaultCodes []codes.Code
+}
+
+// ClientRetryOption настраивает ClientRetry.
+type ClientRetryOption func(*ClientRetry)
+
+// WithMethodRetryCodes задает коды ответа, при которых повторяются вызовы метода method
+// вместо кодов по умолчанию. Коды, при которых сервер мог выполнить запрос, можно задавать
+// только для идемпотентных методов.
+func WithMethodRetryCodes(method string, retryCodes ...codes.Code) ClientRetryOption {
+	return func(r *ClientRetry) {
+		r.methodCodes[method] = retryCodes
+	}
+}
+
+// NewClientRetry создает новый ClientRetry.
+// Задержка перед повторной попыткой удваивается, начиная с backoff.
+func NewClientRetry(maxRetries int, backoff time.Duration, opts ...ClientRetryOption) *ClientRetry {
+	r := &ClientRetry{
+		maxRetries:   maxRetries,
+		backoff:      backoff,
+		methodCodes:  make(map[string][]codes.Code),
+		defaultCodes: []codes.Code{codes.Unavailable},
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Do выполняет вызов с повторными попытками.
+func (r *ClientRetry) Do(
+	ctx context.Context,
+	method string,
+	req, reply interface{},
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+
+	delay := r.backoff
+	for attempt := 1; attempt <= r.maxRetries && r.isRetryable(method, err); attempt++ {
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		delay *= 2
+	}
+
+	return err
+}
+
+func (r *ClientRetry) isRetryable(method string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	retryCodes, ok := r.methodCodes[method]
+	if !ok {
+		retryCodes = r.defaultCodes
+	}
+
+	return slices.Contains(retryCodes, status.Code(err))
+}
@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"net/textproto"
 	"route256/cart/pkg/tracer"
 
 	"go.opentelemetry.io/otel"
@@ -28,7 +29,10 @@ func (t *Tracing) Do(ctx context.Context, req any, info *grpc.UnaryServerInfo, h
 	if !ok {
 		md = metadata.MD{}
 	}
-	md["Traceparent"] = md["traceparent"]
+	// HeaderCarrier ищет заголовки в каноническом виде, а gRPC передает их в нижнем регистре.
+	for _, field := range propagator.Fields() {
+		md[textproto.CanonicalMIMEHeaderKey(field)] = md[field]
+	}
 
 	ctx = propagator.Extract(ctx, propagation.HeaderCarrier(md))
 
@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"time"
+	_ "time/tzdata" // часовые пояса для тихих часов в образе без tzdata
 
 	"route256/cart/pkg/logger"
+	"route256/cart/pkg/myerrgroup"
 	pkgapp "route256/loms/pkg/app"
 	"route256/notifier/internal/app"
 
@@ -40,5 +43,25 @@ func main() {
 		panic(err)
 	}
 
+	go func() {
+		err = startApp(mainCtx, notifierApp)
+		if err != nil && err != http.ErrServerClosed {
+			panic(err)
+		}
+	}()
+
 	pkgapp.GracefulShutdown(&shutdownMain{cancel: cancel, app: notifierApp}, time.Duration(notifierApp.Config.Server.GracefulShutdownTimeout)*time.Second)
 }
+
+func startApp(ctx context.Context, notifierApp *app.App) error {
+	errGroup := myerrgroup.New()
+	errGroup.Go(func() error {
+		return notifierApp.ListenAndServeGRPCGateway(ctx)
+	})
+
+	errGroup.Go(func() error {
+		return notifierApp.ListenAndServeGRPC()
+	})
+
+	return errGroup.Wait()
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"route256/cart/pkg/logger"
+	"route256/notifier/internal/infra/config"
+	"route256/notifier/internal/infra/kafka"
+
+	"go.uber.org/zap"
+)
+
+const (
+	logLevel      = zap.InfoLevel
+	serviceName   = "notifier-dlq-replay"
+	configPathVar = "CONFIG_FILE"
+)
+
+// Переотправляет сообщения из DLQ-топика в основной топик событий заказов.
+func main() {
+	logger.InitLogger(&logger.Config{
+		Level:       logLevel,
+		ServiceName: serviceName,
+	})
+
+	err := run()
+	_ = logger.Sync()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	c, err := config.LoadConfig(os.Getenv(configPathVar))
+	if err != nil {
+		logger.Errorw("config.LoadConfig", "err", err)
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	replayer, err := kafka.NewDeadLetterReplayerKafka(c.Kafka.DLQReplayGroup, []string{c.Kafka.Brokers}, c.Kafka.DLQTopic, c.Kafka.OrderTopic)
+	if err != nil {
+		logger.Errorw("kafka.NewDeadLetterReplayerKafka", "err", err)
+		return err
+	}
+	defer replayer.Close() // nolint:errcheck
+
+	replayed, err := replayer.Replay(ctx)
+	if err != nil {
+		logger.Errorw("DLQ replay failed", "err", err, "replayed", replayed)
+		return err
+	}
+
+	logger.Infow("DLQ replay finished", "replayed", replayed, "from", c.Kafka.DLQTopic, "to", c.Kafka.OrderTopic)
+	return nil
+}
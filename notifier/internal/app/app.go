@@ -2,15 +2,56 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"route256/notifier/internal/domain"
+	"route256/notifier/internal/handler"
 	"route256/notifier/internal/infra/config"
 	"route256/notifier/internal/infra/kafka"
+	"route256/notifier/internal/infra/notification"
+	"route256/notifier/internal/infra/repository"
+	"route256/notifier/internal/infra/repository/postgres"
+	"route256/notifier/internal/infra/template"
 	"route256/notifier/internal/service"
+	"route256/notifier/pkg/api/preferences/v1"
+
+	"route256/cart/pkg/logger"
+	"route256/cart/pkg/myerrgroup"
+	postgrespkg "route256/cart/pkg/postgres"
+	"route256/cart/pkg/tracer"
+	"route256/loms/pkg/api/orders/v1"
+	"route256/loms/pkg/grpc/interceptor"
+	"route256/loms/pkg/http/middleware"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
 )
 
+const healthCheckTimeout = 2 * time.Second
+
+var errOrderEventSubscrStopped = errors.New("order event subscription stopped")
+
 // App создает компоненты для сервиса notifier
 type App struct {
-	Config *config.Config
+	Config        *config.Config
+	grpcServer    *grpc.Server
+	grpcGWServer  *http.Server
+	tracerManager *tracer.Manager
+
+	pool             *pgxpool.Pool
+	deadLetterPub    *kafka.DeadLetterTopicKafka
+	lomsConn         *grpc.ClientConn
+	orderEventSubscr *kafka.Subscription
+	deferredWorker   *service.DeferredNotificationWorker
 }
 
 // NewApp конструктор главного приложения.
@@ -21,19 +62,278 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 	}
 
 	a := &App{Config: c}
+	tracingConfig := a.Config.Server.Tracing
+	a.tracerManager, err = tracer.NewTracerManager(ctx, tracer.Config{
+		ServiceName:  tracingConfig.ServiceName,
+		Environment:  tracingConfig.Environment,
+		Exporter:     tracingConfig.Exporter,
+		Endpoint:     fmt.Sprintf("http://%s:%s", a.Config.Jaeger.Host, a.Config.Jaeger.Port),
+		SampleRatio:  *tracingConfig.SampleRatio,
+		ParentBased:  tracingConfig.ParentBased,
+		SampleErrors: tracingConfig.SampleErrors,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tracer.NewTracerManager: %w", err)
+	}
+
+	a.grpcServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptor.NewTracing(a.tracerManager).Do,
+			interceptor.Logging,
+			interceptor.Validate,
+		),
+	)
 
-	orderEventService := service.NewOrderEventService()
+	reflection.Register(a.grpcServer)
 
-	orderEventConsumer, err := kafka.NewOrderEventTopicSubKafka(a.Config.Kafka.ConsumerGroupID, []string{a.Config.Kafka.OrderTopic}, []string{a.Config.Kafka.Brokers}, orderEventService)
+	dbConfig := a.Config.DB
+	a.pool, err = newPool(ctx, dsnBuilder(dbConfig.User, dbConfig.Password, dbConfig.Host, dbConfig.Port, dbConfig.DBName),
+		postgrespkg.NewQueryTracer(a.tracerManager.Tracer, "main"))
 	if err != nil {
-		return nil, fmt.Errorf("config.LoadConfig: %w", err)
+		return nil, fmt.Errorf("newPool: %w", err)
 	}
-	orderEventConsumer.Start(ctx)
+
+	preferencesRepository := postgres.NewPreferencesRepository(a.pool)
+	preferencesService := service.NewPreferencesService(preferencesRepository)
+
+	preferences.RegisterPreferencesServiceV1Server(a.grpcServer, handler.NewPreferencesServerGRPC(preferencesService))
+
+	orderEventService, err := a.bootstrapOrderEventService(preferencesService)
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetterPub, err := kafka.NewDeadLetterTopicKafka([]string{a.Config.Kafka.Brokers}, a.Config.Kafka.DLQTopic)
+	if err != nil {
+		return nil, fmt.Errorf("kafka.NewDeadLetterTopicKafka: %w", err)
+	}
+	a.deadLetterPub = deadLetterPub
+
+	orderEventConsumer, err := kafka.NewOrderEventTopicSubKafka(a.Config.Kafka.ConsumerGroupID, []string{a.Config.Kafka.OrderTopic}, []string{a.Config.Kafka.Brokers}, orderEventService, deadLetterPub, kafka.RetryConfig{
+		MaxRetries: a.Config.Kafka.ProcessMaxRetries,
+		Backoff:    time.Duration(a.Config.Kafka.ProcessRetryBackoffMs) * time.Millisecond,
+	}, a.tracerManager)
+	if err != nil {
+		return nil, fmt.Errorf("kafka.NewOrderEventTopicSubKafka: %w", err)
+	}
+	a.orderEventSubscr = orderEventConsumer.Start(ctx)
+
+	deferredConfig := a.Config.Notifications.Deferred
+	a.deferredWorker = service.NewDeferredNotificationWorker(orderEventService, time.Duration(deferredConfig.PeriodMs)*time.Millisecond, deferredConfig.BatchSize)
+	a.deferredWorker.Start(ctx)
 
 	return a, nil
 }
 
+func (a *App) bootstrapOrderEventService(preferencesService service.PreferencesProvider) (*service.OrderEventService, error) {
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("%s:%s", a.Config.LomsService.Host, a.Config.LomsService.Port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			interceptor.ClientTracing,
+			interceptor.ClientMetrics,
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("grpc.NewClient: %w", err)
+	}
+	a.lomsConn = conn
+	lomsService := service.NewLomsServiceGRPC(orders.NewOrderServiceV1Client(conn))
+
+	statusTemplates := make(map[string]template.StatusTemplate, len(a.Config.Notifications.Templates))
+	for status, t := range a.Config.Notifications.Templates {
+		statusTemplates[status] = template.StatusTemplate{
+			Subject: t.Subject,
+			Body:    t.Body,
+		}
+	}
+	renderer, err := template.NewNotificationTemplates(statusTemplates)
+	if err != nil {
+		return nil, fmt.Errorf("template.NewNotificationTemplates: %w", err)
+	}
+
+	contacts := make([]*domain.Contact, 0, len(a.Config.Contacts))
+	for _, c := range a.Config.Contacts {
+		contacts = append(contacts, &domain.Contact{
+			UserID:     c.UserID,
+			Email:      c.Email,
+			WebhookURL: c.WebhookURL,
+		})
+	}
+	contactRepo := repository.NewContactRepositoryInMemory(contacts)
+
+	channels := make([]service.NotificationChannel, 0, 2)
+	if emailCfg := a.Config.Notifications.Email; emailCfg.Enabled {
+		channels = append(channels, notification.NewEmailChannel(
+			emailCfg.Host,
+			emailCfg.Port,
+			emailCfg.From,
+			emailCfg.Username,
+			emailCfg.Password,
+			time.Duration(emailCfg.TimeoutMs)*time.Millisecond,
+		))
+	}
+	if webhookCfg := a.Config.Notifications.Webhook; webhookCfg.Enabled {
+		channels = append(channels, notification.NewWebhookChannel(&http.Client{
+			Timeout: time.Duration(webhookCfg.TimeoutMs) * time.Millisecond,
+		}))
+	}
+
+	processedEventRepo := postgres.NewProcessedEventRepository(a.pool)
+	deferredNotificationRepo := postgres.NewDeferredNotificationRepository(a.pool)
+
+	return service.NewOrderEventService(processedEventRepo, deferredNotificationRepo, contactRepo, lomsService, preferencesService, renderer, channels), nil
+}
+
+func dsnBuilder(user, password, host string, port int64, dbname string) string {
+	return fmt.Sprintf("postgresql://%s:%s@%s:%d/%s?sslmode=disable", user, password, host, port, dbname)
+}
+
+func newPool(ctx context.Context, dsn string, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.ParseConfig (dsn=%s): %w", dsn, err)
+	}
+
+	config.ConnConfig.Tracer = tracer
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, fmt.Errorf("pgxpool.NewWithConfig (dsn=%s): %w", dsn, err)
+	}
+
+	return pool, nil
+}
+
+// ListenAndServeGRPC запускает gRPC-сервер приложения.
+func (a *App) ListenAndServeGRPC() error {
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", a.Config.Server.GRPCPort))
+	if err != nil {
+		return fmt.Errorf("net.Listen: %w", err)
+	}
+
+	logger.Infow(fmt.Sprintf("Notifier service listening gRPC at port %s", a.Config.Server.GRPCPort))
+
+	return a.grpcServer.Serve(listener)
+}
+
+// ListenAndServeGRPCGateway запускает gRPC-gateway для gRPC-сервера приложений.
+func (a *App) ListenAndServeGRPCGateway(ctx context.Context) error {
+	conn, err := grpc.NewClient(
+		fmt.Sprintf(":%s", a.Config.Server.GRPCPort),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return fmt.Errorf("grpc.NewClient: %w", err)
+	}
+
+	gwMux := runtime.NewServeMux()
+
+	err = preferences.RegisterPreferencesServiceV1Handler(ctx, gwMux, conn)
+	if err != nil {
+		return fmt.Errorf("preferences.RegisterPreferencesServiceV1Handler: %w", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gwMux)
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/health", handler.NewHealthHandler(map[string]handler.HealthCheck{
+		"postgres":       a.pool.Ping,
+		"kafka_consumer": a.checkOrderEventSubscr,
+	}, healthCheckTimeout))
+
+	corsHandler := middleware.CORSAllPass(mux)
+
+	readHeaderTimeout, err := time.ParseDuration(a.Config.Server.GRPCGateWay.ReadHeaderTimeout)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	writeTimeout, err := time.ParseDuration(a.Config.Server.GRPCGateWay.WriteTimeout)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+	idleTimeout, err := time.ParseDuration(a.Config.Server.GRPCGateWay.IdleTimeout)
+	if err != nil {
+		return fmt.Errorf("time.ParseDuration: %w", err)
+	}
+
+	a.grpcGWServer = &http.Server{
+		Addr:              fmt.Sprintf(":%s", a.Config.Server.HTTPPort),
+		Handler:           corsHandler,
+		ReadHeaderTimeout: readHeaderTimeout,
+		WriteTimeout:      writeTimeout,
+		IdleTimeout:       idleTimeout,
+	}
+
+	logger.Infow(fmt.Sprintf("Notifier service listening gRPC-Gateway (REST) at port %s", a.Config.Server.HTTPPort))
+
+	return a.grpcGWServer.ListenAndServe()
+}
+
+func (a *App) checkOrderEventSubscr(_ context.Context) error {
+	select {
+	case <-a.orderEventSubscr.Done():
+		return errOrderEventSubscrStopped
+	default:
+		return nil
+	}
+}
+
 // Shutdown gracefully останавливает приложение.
-func (a *App) Shutdown(_ context.Context) error {
-	return nil
+// Сначала дожидается обработки и коммита уже полученных событий заказа,
+// затем останавливает серверы и закрывает соединения.
+// Ошибка на любом шаге не прерывает остановку: все ошибки возвращаются вместе.
+func (a *App) Shutdown(ctx context.Context) error {
+	var errs []error
+
+	err := a.orderEventSubscr.Stop(ctx)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("orderEventSubscr.Stop: %w", err))
+	}
+
+	a.deferredWorker.Stop()
+
+	errGroup, groupCtx := myerrgroup.WithContext(ctx)
+	errGroup.Go(func() error {
+		return a.tracerManager.Stop(groupCtx)
+	})
+
+	errGroup.Go(func() error {
+		return a.grpcGWServer.Shutdown(groupCtx)
+	})
+
+	errGroup.Go(func() error {
+		successGraceful := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(successGraceful)
+		}()
+
+		select {
+		case <-groupCtx.Done():
+			a.grpcServer.Stop()
+			return groupCtx.Err()
+		case <-successGraceful:
+			return nil
+		}
+	})
+
+	err = errGroup.Wait()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	err = a.deadLetterPub.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("deadLetterPub.Close: %w", err))
+	}
+
+	err = a.lomsConn.Close()
+	if err != nil {
+		errs = append(errs, fmt.Errorf("lomsConn.Close: %w", err))
+	}
+
+	a.pool.Close()
+
+	return errors.Join(errs...)
 }
@@ -0,0 +1,8 @@
+package domain
+
+// Contact описывает контактные данные пользователя для отправки уведомлений.
+type Contact struct {
+	UserID     int64
+	Email      string
+	WebhookURL string
+}
@@ -0,0 +1,9 @@
+package domain
+
+import "time"
+
+// DeferredNotification описывает уведомление о событии заказа, отложенное до окончания тихих часов пользователя.
+type DeferredNotification struct {
+	Event     *OrderEvent
+	SendAfter time.Time
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "errors"
+
+var (
+	// ErrContactNotFound контакты пользователя не найдены.
+	ErrContactNotFound = errors.New("контакты пользователя не найдены")
+	// ErrNoChannelAddress у пользователя нет адреса для данного канала уведомлений.
+	ErrNoChannelAddress = errors.New("у пользователя нет адреса для канала уведомлений")
+	// ErrTemplateNotFound шаблон уведомления для статуса заказа не найден.
+	ErrTemplateNotFound = errors.New("шаблон уведомления для статуса заказа не найден")
+	// ErrPreferencesNotFound настройки уведомлений пользователя не найдены.
+	ErrPreferencesNotFound = errors.New("настройки уведомлений пользователя не найдены")
+	// ErrTimezoneNotValid неизвестный часовой пояс.
+	ErrTimezoneNotValid = errors.New("неизвестный часовой пояс")
+)
@@ -0,0 +1,9 @@
+package domain
+
+// Notification описывает уведомление пользователя о смене статуса заказа.
+type Notification struct {
+	OrderID int64
+	Status  string
+	Subject string
+	Body    string
+}
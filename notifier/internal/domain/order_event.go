@@ -1,8 +1,24 @@
 package domain
 
+import (
+	"fmt"
+	"strconv"
+)
+
 // OrderEvent описывает событие по заказу.
 type OrderEvent struct {
+	EventID int64
 	OrderID int64
 	Status  string
 	Moment  string
 }
+
+// DedupKey возвращает ключ для дедупликации события.
+// Если у события нет ID (старый формат сообщения), ключ строится по его содержимому.
+func (e *OrderEvent) DedupKey() string {
+	if e.EventID > 0 {
+		return strconv.FormatInt(e.EventID, 10)
+	}
+
+	return fmt.Sprintf("%d:%s:%s", e.OrderID, e.Status, e.Moment)
+}
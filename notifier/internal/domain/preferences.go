@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+const (
+	// ChannelEmail канал email-уведомлений.
+	ChannelEmail = "email"
+	// ChannelWebhook канал webhook-уведомлений.
+	ChannelWebhook = "webhook"
+)
+
+var (
+	// AllChannels все доступные каналы уведомлений.
+	AllChannels = []string{ChannelEmail, ChannelWebhook}
+	// AllOrderStatuses все статусы заказа, о которых можно получать уведомления.
+	AllOrderStatuses = []string{"new", "awaiting payment", "failed", "paid", "cancelled"}
+)
+
+// NotificationPreferences описывает настройки уведомлений пользователя.
+type NotificationPreferences struct {
+	UserID     int64
+	Channels   []string
+	Statuses   []string
+	QuietHours *QuietHours
+}
+
+// QuietHours описывает интервал времени суток, в который уведомления не отправляются.
+// Start и End задаются смещением от начала суток в часовом поясе Timezone.
+// Если Start больше End, интервал проходит через полночь.
+type QuietHours struct {
+	Start    time.Duration
+	End      time.Duration
+	Timezone string
+}
+
+// DefaultNotificationPreferences возвращает настройки по умолчанию: все каналы и все статусы без тихих часов.
+func DefaultNotificationPreferences(userID int64) *NotificationPreferences {
+	return &NotificationPreferences{
+		UserID:   userID,
+		Channels: slices.Clone(AllChannels),
+		Statuses: slices.Clone(AllOrderStatuses),
+	}
+}
+
+// AllowsChannel проверяет, включен ли у пользователя канал уведомлений.
+func (p *NotificationPreferences) AllowsChannel(channel string) bool {
+	return slices.Contains(p.Channels, channel)
+}
+
+// AllowsStatus проверяет, интересует ли пользователя статус заказа.
+func (p *NotificationPreferences) AllowsStatus(status string) bool {
+	return slices.Contains(p.Statuses, status)
+}
+
+// IsQuietAt проверяет, попадает ли момент времени в тихие часы пользователя.
+func (p *NotificationPreferences) IsQuietAt(t time.Time) bool {
+	if p.QuietHours == nil {
+		return false
+	}
+
+	return p.QuietHours.Contains(t)
+}
+
+// Contains проверяет, попадает ли момент времени в интервал тихих часов.
+func (q *QuietHours) Contains(t time.Time) bool {
+	t = t.In(q.location())
+
+	sinceMidnight := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+
+	if q.Start <= q.End {
+		return sinceMidnight >= q.Start && sinceMidnight < q.End
+	}
+
+	return sinceMidnight >= q.Start || sinceMidnight < q.End
+}
+
+// EndAfter возвращает ближайший после t момент окончания тихих часов.
+func (q *QuietHours) EndAfter(t time.Time) time.Time {
+	t = t.In(q.location())
+
+	year, month, day := t.Date()
+	end := time.Date(year, month, day, 0, 0, 0, 0, t.Location()).Add(q.End)
+	if !end.After(t) {
+		end = time.Date(year, month, day+1, 0, 0, 0, 0, t.Location()).Add(q.End)
+	}
+
+	return end
+}
+
+func (q *QuietHours) location() *time.Location {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"route256/cart/pkg/logger"
+)
+
+// HealthCheck проверяет работоспособность одного из компонентов сервиса.
+type HealthCheck func(ctx context.Context) error
+
+// HealthHandler отдает состояние сервиса по результатам проверок компонентов.
+type HealthHandler struct {
+	checks  map[string]HealthCheck
+	timeout time.Duration
+}
+
+// NewHealthHandler создает HealthHandler.
+func NewHealthHandler(checks map[string]HealthCheck, timeout time.Duration) *HealthHandler {
+	return &HealthHandler{
+		checks:  checks,
+		timeout: timeout,
+	}
+}
+
+type healthResponse struct {
+	Status string            `json:"status"`
+	Errors map[string]string `json:"errors,omitempty"`
+}
+
+// ServeHTTP отвечает 200, если все проверки прошли, иначе 503 со списком ошибок.
+func (h *HealthHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), h.timeout)
+	defer cancel()
+
+	resp := healthResponse{Status: "ok"}
+	statusCode := http.StatusOK
+
+	for name, check := range h.checks {
+		err := check(ctx)
+		if err == nil {
+			continue
+		}
+
+		if resp.Errors == nil {
+			resp.Errors = make(map[string]string, len(h.checks))
+		}
+		resp.Errors[name] = err.Error()
+		resp.Status = "unavailable"
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	err := json.NewEncoder(w).Encode(resp)
+	if err != nil {
+		logger.Errorw("health response encode error", "err", err)
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	ok := func(_ context.Context) error { return nil }
+	fail := func(_ context.Context) error { return errors.New("connection refused") }
+
+	tests := []struct {
+		name       string
+		checks     map[string]HealthCheck
+		wantCode   int
+		wantStatus string
+		wantErrors map[string]string
+	}{
+		{
+			name:       "all checks pass",
+			checks:     map[string]HealthCheck{"postgres": ok, "kafka_consumer": ok},
+			wantCode:   http.StatusOK,
+			wantStatus: "ok",
+		},
+		{
+			name:       "one check fails",
+			checks:     map[string]HealthCheck{"postgres": fail, "kafka_consumer": ok},
+			wantCode:   http.StatusServiceUnavailable,
+			wantStatus: "unavailable",
+			wantErrors: map[string]string{"postgres": "connection refused"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := NewHealthHandler(tt.checks, time.Second)
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+
+			var resp healthResponse
+			require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+			assert.Equal(t, tt.wantStatus, resp.Status)
+			assert.Equal(t, tt.wantErrors, resp.Errors)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"route256/notifier/internal/domain"
+	"route256/notifier/pkg/api/preferences/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const quietHoursLayout = "15:04"
+
+var (
+	errEmptyChannels = errors.New("preferences.channels must not be empty")
+	errEmptyStatuses = errors.New("preferences.statuses must not be empty")
+)
+
+type preferencesService interface {
+	Get(ctx context.Context, userID int64) (*domain.NotificationPreferences, error)
+	Update(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+// PreferencesServerGRPC обрабатывает gRPC-запросы для операций с настройками уведомлений.
+type PreferencesServerGRPC struct {
+	preferences.UnimplementedPreferencesServiceV1Server
+	preferencesService preferencesService
+}
+
+// NewPreferencesServerGRPC создает новый экземпляр PreferencesServerGRPC.
+func NewPreferencesServerGRPC(preferencesService preferencesService) *PreferencesServerGRPC {
+	return &PreferencesServerGRPC{
+		preferencesService: preferencesService,
+	}
+}
+
+// GetPreferencesV1 возвращает настройки уведомлений пользователя.
+func (p *PreferencesServerGRPC) GetPreferencesV1(ctx context.Context, req *preferences.GetPreferencesRequest) (*preferences.GetPreferencesResponse, error) {
+	prefs, err := p.preferencesService.Get(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Errorf("internal server error: %w", err).Error())
+	}
+
+	res := &preferences.GetPreferencesResponse{
+		Preferences: &preferences.Preferences{
+			Channels: prefs.Channels,
+			Statuses: prefs.Statuses,
+		},
+	}
+
+	if prefs.QuietHours != nil {
+		res.Preferences.QuietHours = &preferences.QuietHours{
+			Start:    formatTimeOfDay(prefs.QuietHours.Start),
+			End:      formatTimeOfDay(prefs.QuietHours.End),
+			Timezone: prefs.QuietHours.Timezone,
+		}
+	}
+
+	return res, nil
+}
+
+// UpdatePreferencesV1 сохраняет настройки уведомлений пользователя.
+// Настройки заменяются целиком, поэтому пустые списки каналов и статусов отклоняются:
+// в proto3 их нельзя отличить от незаданных, и запрос, меняющий только тихие часы,
+// молча отписал бы пользователя от всех уведомлений.
+func (p *PreferencesServerGRPC) UpdatePreferencesV1(ctx context.Context, req *preferences.UpdatePreferencesRequest) (*preferences.UpdatePreferencesResponse, error) {
+	if len(req.Preferences.Channels) == 0 {
+		return nil, status.Error(codes.InvalidArgument, errEmptyChannels.Error())
+	}
+	if len(req.Preferences.Statuses) == 0 {
+		return nil, status.Error(codes.InvalidArgument, errEmptyStatuses.Error())
+	}
+
+	prefs := &domain.NotificationPreferences{
+		UserID:   req.UserId,
+		Channels: req.Preferences.Channels,
+		Statuses: req.Preferences.Statuses,
+	}
+
+	if qh := req.Preferences.QuietHours; qh != nil {
+		start, err := parseTimeOfDay(qh.Start)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		end, err := parseTimeOfDay(qh.End)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		prefs.QuietHours = &domain.QuietHours{
+			Start:    start,
+			End:      end,
+			Timezone: qh.Timezone,
+		}
+	}
+
+	err := p.preferencesService.Update(ctx, prefs)
+	if err != nil {
+		if errors.Is(err, domain.ErrTimezoneNotValid) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, fmt.Errorf("internal server error: %w", err).Error())
+	}
+
+	return &preferences.UpdatePreferencesResponse{}, nil
+}
+
+func parseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse(quietHoursLayout, value)
+	if err != nil {
+		return 0, fmt.Errorf("time.Parse: %w", err)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func formatTimeOfDay(d time.Duration) string {
+	return time.Time{}.Add(d).Format(quietHoursLayout)
+}
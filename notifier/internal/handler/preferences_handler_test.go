@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"route256/notifier/internal/domain"
+	"route256/notifier/internal/service"
+	mock "route256/notifier/mocks"
+	"route256/notifier/pkg/api/preferences/v1"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPreferencesServerGRPC_UpdatePreferencesV1(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	quietHours := &preferences.QuietHours{Start: "22:00", End: "08:00", Timezone: "Europe/Moscow"}
+
+	tests := []struct {
+		name     string
+		prefs    *preferences.Preferences
+		wantCode codes.Code
+	}{
+		{
+			name:     "только тихие часы без каналов отклоняются",
+			prefs:    &preferences.Preferences{Statuses: []string{"paid"}, QuietHours: quietHours},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "только тихие часы без статусов отклоняются",
+			prefs:    &preferences.Preferences{Channels: []string{domain.ChannelEmail}, QuietHours: quietHours},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repoMock := mock.NewPreferencesRepositoryMock(minimock.NewController(t))
+			server := NewPreferencesServerGRPC(service.NewPreferencesService(repoMock))
+
+			_, err := server.UpdatePreferencesV1(ctx, &preferences.UpdatePreferencesRequest{UserId: 1, Preferences: tt.prefs})
+			require.Error(t, err)
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+
+	t.Run("настройки сохраняются целиком", func(t *testing.T) {
+		t.Parallel()
+
+		repoMock := mock.NewPreferencesRepositoryMock(minimock.NewController(t))
+		server := NewPreferencesServerGRPC(service.NewPreferencesService(repoMock))
+
+		repoMock.UpsertMock.Expect(ctx, &domain.NotificationPreferences{
+			UserID:   1,
+			Channels: []string{domain.ChannelEmail},
+			Statuses: []string{"paid"},
+			QuietHours: &domain.QuietHours{
+				Start:    22 * time.Hour,
+				End:      8 * time.Hour,
+				Timezone: "Europe/Moscow",
+			},
+		}).Return(nil)
+
+		_, err := server.UpdatePreferencesV1(ctx, &preferences.UpdatePreferencesRequest{
+			UserId: 1,
+			Preferences: &preferences.Preferences{
+				Channels:   []string{domain.ChannelEmail},
+				Statuses:   []string{"paid"},
+				QuietHours: quietHours,
+			},
+		})
+		require.NoError(t, err)
+	})
+}
@@ -9,8 +9,13 @@ import (
 
 // Config главный конфиг сервиса.
 type Config struct {
-	Server ServiceConfig `yaml:"service"`
-	Kafka  KafkaConfig   `yaml:"kafka"`
+	Server        ServiceConfig       `yaml:"service"`
+	Kafka         KafkaConfig         `yaml:"kafka"`
+	LomsService   LomsServiceConfig   `yaml:"loms_service"`
+	Notifications NotificationsConfig `yaml:"notifications"`
+	Contacts      []ContactConfig     `yaml:"contacts"`
+	DB            DBConfig            `yaml:"db"`
+	Jaeger        JaegerConfig        `yaml:"jaeger"`
 }
 
 // KafkaConfig конфиг для kafka.
@@ -18,11 +23,106 @@ type KafkaConfig struct {
 	OrderTopic      string `yaml:"order_topic"`
 	ConsumerGroupID string `yaml:"consumer_group_id"`
 	Brokers         string `yaml:"brokers"`
+	DLQTopic        string `yaml:"dlq_topic"`
+	DLQReplayGroup  string `yaml:"dlq_replay_group_id"`
+
+	ProcessMaxRetries     int   `yaml:"process_max_retries"`
+	ProcessRetryBackoffMs int64 `yaml:"process_retry_backoff_ms"`
 }
 
 // Config конфиг для текущего сервиса.
 type ServiceConfig struct {
-	GracefulShutdownTimeout int64 `yaml:"graceful_shutdown_timeout"`
+	Host                    string            `yaml:"host"`
+	GRPCPort                string            `yaml:"grpc_port"`
+	HTTPPort                string            `yaml:"http_port"`
+	GRPCGateWay             GRPCGateWayConfig `yaml:"grpc_gateway"`
+	GracefulShutdownTimeout int64             `yaml:"graceful_shutdown_timeout"`
+	Tracing                 TracingConfig     `yaml:"tracing"`
+}
+
+// GRPCGateWayConfig конфиг для gRPC-gateway.
+type GRPCGateWayConfig struct {
+	ReadHeaderTimeout string `yaml:"read_header_timeout"`
+	WriteTimeout      string `yaml:"write_timeout"`
+	IdleTimeout       string `yaml:"idle_timeout"`
+}
+
+// JaegerConfig конфиг для jaeger.
+type JaegerConfig struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+}
+
+// defaultSampleRatio доля трейсов в выборке, если sample_ratio не задан.
+const defaultSampleRatio = 1.0
+
+// TracingConfig конфиг для трассировки.
+type TracingConfig struct {
+	ServiceName  string   `yaml:"service_name"`
+	Environment  string   `yaml:"environment"`
+	Exporter     string   `yaml:"exporter"`
+	SampleRatio  *float64 `yaml:"sample_ratio"`
+	ParentBased  bool     `yaml:"parent_based"`
+	SampleErrors bool     `yaml:"sample_errors"`
+}
+
+// DBConfig конфиг для БД.
+type DBConfig struct {
+	Host     string `yaml:"host"`
+	Port     int64  `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	DBName   string `yaml:"db_name"`
+}
+
+// LomsServiceConfig конфиг для сервиса loms.
+type LomsServiceConfig struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+}
+
+// NotificationsConfig конфиг для каналов уведомлений.
+type NotificationsConfig struct {
+	Email     EmailConfig               `yaml:"email"`
+	Webhook   WebhookConfig             `yaml:"webhook"`
+	Deferred  DeferredConfig            `yaml:"deferred"`
+	Templates map[string]TemplateConfig `yaml:"templates"`
+}
+
+// EmailConfig конфиг для отправки уведомлений по SMTP.
+type EmailConfig struct {
+	Enabled   bool   `yaml:"enabled"`
+	Host      string `yaml:"host"`
+	Port      string `yaml:"port"`
+	From      string `yaml:"from"`
+	Username  string `yaml:"username"`
+	Password  string `yaml:"password"`
+	TimeoutMs int64  `yaml:"timeout_ms"`
+}
+
+// WebhookConfig конфиг для отправки уведомлений на webhook.
+type WebhookConfig struct {
+	Enabled   bool  `yaml:"enabled"`
+	TimeoutMs int64 `yaml:"timeout_ms"`
+}
+
+// DeferredConfig конфиг для отправки уведомлений, отложенных до окончания тихих часов.
+type DeferredConfig struct {
+	PeriodMs  int64 `yaml:"period_ms"`
+	BatchSize int   `yaml:"batch_size"`
+}
+
+// TemplateConfig шаблон уведомления для статуса заказа.
+type TemplateConfig struct {
+	Subject string `yaml:"subject"`
+	Body    string `yaml:"body"`
+}
+
+// ContactConfig контакты пользователя.
+type ContactConfig struct {
+	UserID     int64  `yaml:"user_id"`
+	Email      string `yaml:"email"`
+	WebhookURL string `yaml:"webhook_url"`
 }
 
 // LoadConfig загружает конфиг из файла .yaml
@@ -38,5 +138,24 @@ func LoadConfig(filename string) (*Config, error) {
 		return nil, fmt.Errorf("ошибка при декодировании yaml файла-конфига: %w", err)
 	}
 
+	if err := config.Server.Tracing.setDefaults(); err != nil {
+		return nil, err
+	}
+
 	return config, nil
 }
+
+// setDefaults задает значения по умолчанию и проверяет настройки трассировки.
+func (c *TracingConfig) setDefaults() error {
+	if c.SampleRatio == nil {
+		sampleRatio := defaultSampleRatio
+		c.SampleRatio = &sampleRatio
+		return nil
+	}
+
+	if *c.SampleRatio < 0 || *c.SampleRatio > 1 {
+		return fmt.Errorf("sample_ratio должен быть от 0 до 1, получено %v", *c.SampleRatio)
+	}
+
+	return nil
+}
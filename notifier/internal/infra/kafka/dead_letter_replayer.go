@@ -0,0 +1,182 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"route256/cart/pkg/logger"
+	"sync"
+	"sync/atomic"
+
+	"github.com/IBM/sarama"
+)
+
+// DeadLetterReplayerKafka переотправляет сообщения из DLQ-топика в основной топик.
+type DeadLetterReplayerKafka struct {
+	consumerGroup sarama.ConsumerGroup
+	producer      sarama.SyncProducer
+	dlqTopic      string
+	targetTopic   string
+}
+
+// NewDeadLetterReplayerKafka создает новый экземпляр DeadLetterReplayerKafka.
+func NewDeadLetterReplayerKafka(groupID string, brokers []string, dlqTopic, targetTopic string) (*DeadLetterReplayerKafka, error) {
+	r := &DeadLetterReplayerKafka{
+		dlqTopic:    dlqTopic,
+		targetTopic: targetTopic,
+	}
+
+	config := sarama.NewConfig()
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+	config.Consumer.Offsets.AutoCommit.Enable = true
+
+	var err error
+	r.consumerGroup, err = sarama.NewConsumerGroup(brokers, groupID, config)
+	if err != nil {
+		return nil, fmt.Errorf("sarama.NewConsumerGroup: %w", err)
+	}
+
+	r.producer, err = sarama.NewSyncProducer(brokers, newProducerConfig())
+	if err != nil {
+		_ = r.consumerGroup.Close()
+		return nil, fmt.Errorf("sarama.NewSyncProducer: %w", err)
+	}
+
+	return r, nil
+}
+
+// Replay вычитывает DLQ-топик до текущего конца и переотправляет сообщения в основной топик.
+// Возвращает количество переотправленных сообщений.
+func (r *DeadLetterReplayerKafka) Replay(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	h := &replayHandler{
+		producer:    r.producer,
+		targetTopic: r.targetTopic,
+		done:        cancel,
+	}
+
+	err := r.consumerGroup.Consume(ctx, []string{r.dlqTopic}, h)
+	if err != nil && ctx.Err() == nil {
+		return h.replayed.Load(), fmt.Errorf("consumerGroup.Consume: %w", err)
+	}
+
+	return h.replayed.Load(), h.err
+}
+
+// Close закрывает консьюмер и продюсер.
+func (r *DeadLetterReplayerKafka) Close() error {
+	errConsumer := r.consumerGroup.Close()
+	errProducer := r.producer.Close()
+
+	if errConsumer != nil {
+		return fmt.Errorf("consumerGroup.Close: %w", errConsumer)
+	}
+	if errProducer != nil {
+		return fmt.Errorf("producer.Close: %w", errProducer)
+	}
+
+	return nil
+}
+
+type replayHandler struct {
+	producer    sarama.SyncProducer
+	targetTopic string
+	done        context.CancelFunc
+
+	replayed atomic.Int64
+	pending  atomic.Int32
+	errOnce  sync.Once
+	err      error
+}
+
+func (h *replayHandler) Setup(sess sarama.ConsumerGroupSession) error {
+	var claims int32
+	for _, partitions := range sess.Claims() {
+		claims += int32(len(partitions))
+	}
+	h.pending.Store(claims)
+
+	if claims == 0 {
+		h.done()
+	}
+
+	return nil
+}
+
+func (h *replayHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *replayHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	defer func() {
+		if h.pending.Add(-1) == 0 {
+			h.done()
+		}
+	}()
+
+	// Переотправляем только сообщения, которые были в DLQ на момент запуска.
+	highWaterMark := claim.HighWaterMarkOffset()
+	if claim.InitialOffset() >= highWaterMark {
+		return nil
+	}
+
+	for {
+		select {
+		case <-sess.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			err := h.republish(msg)
+			if err != nil {
+				h.errOnce.Do(func() { h.err = err })
+				h.done()
+				return err
+			}
+
+			sess.MarkMessage(msg, "")
+			h.replayed.Add(1)
+
+			if msg.Offset+1 >= highWaterMark {
+				return nil
+			}
+		}
+	}
+}
+
+// republish отправляет сообщение в основной топик без служебных DLQ-заголовков.
+func (h *replayHandler) republish(msg *sarama.ConsumerMessage) error {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers))
+	for _, hdr := range msg.Headers {
+		if hdr == nil || isDLQHeader(hdr.Key) {
+			continue
+		}
+		headers = append(headers, *hdr)
+	}
+
+	replayMsg := &sarama.ProducerMessage{
+		Topic:   h.targetTopic,
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}
+	if msg.Key != nil {
+		replayMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	_, _, err := h.producer.SendMessage(replayMsg)
+	if err != nil {
+		logger.Errorw("can't replay kafka message", "err", err, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+		return fmt.Errorf("producer.SendMessage: %w", err)
+	}
+
+	return nil
+}
+
+func isDLQHeader(key []byte) bool {
+	return bytes.Equal(key, []byte(HeaderDLQError)) ||
+		bytes.Equal(key, []byte(HeaderDLQSourceTopic)) ||
+		bytes.Equal(key, []byte(HeaderDLQSourcePartition)) ||
+		bytes.Equal(key, []byte(HeaderDLQSourceOffset))
+}
@@ -0,0 +1,194 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProducer struct {
+	sarama.SyncProducer
+	sent []*sarama.ProducerMessage
+	err  error
+}
+
+func (p *fakeProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	if p.err != nil {
+		return 0, 0, p.err
+	}
+	p.sent = append(p.sent, msg)
+
+	return 0, int64(len(p.sent) - 1), nil
+}
+
+type fakeReplayClaim struct {
+	*fakeClaim
+	initialOffset int64
+	highWaterMark int64
+}
+
+func (c *fakeReplayClaim) InitialOffset() int64 { return c.initialOffset }
+
+func (c *fakeReplayClaim) HighWaterMarkOffset() int64 { return c.highWaterMark }
+
+func headersMap(headers []sarama.RecordHeader) map[string]string {
+	m := make(map[string]string, len(headers))
+	for _, h := range headers {
+		m[string(h.Key)] = string(h.Value)
+	}
+
+	return m
+}
+
+func encode(t *testing.T, e sarama.Encoder) string {
+	t.Helper()
+
+	b, err := e.Encode()
+	require.NoError(t, err)
+
+	return string(b)
+}
+
+func deadLetterMessage(offset int64) *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Topic:  "loms.order-events.dlq",
+		Offset: offset,
+		Key:    []byte("10"),
+		Value:  []byte("not json"),
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte("traceparent"), Value: []byte("00-trace-span-01")},
+			{Key: []byte(HeaderDLQError), Value: []byte("json.Unmarshal: invalid character")},
+			{Key: []byte(HeaderDLQSourceTopic), Value: []byte("loms.order-events")},
+			{Key: []byte(HeaderDLQSourcePartition), Value: []byte("2")},
+			{Key: []byte(HeaderDLQSourceOffset), Value: []byte("42")},
+		},
+	}
+}
+
+func TestDeadLetterTopicKafka_Send(t *testing.T) {
+	t.Parallel()
+
+	t.Run("сообщение отправляется в DLQ с исходными данными и причиной ошибки", func(t *testing.T) {
+		t.Parallel()
+
+		producer := &fakeProducer{}
+		d := &DeadLetterTopicKafka{producer: producer, topic: "loms.order-events.dlq"}
+		msg := &sarama.ConsumerMessage{
+			Topic:     "loms.order-events",
+			Partition: 2,
+			Offset:    42,
+			Key:       []byte("10"),
+			Value:     []byte("not json"),
+			Headers:   []*sarama.RecordHeader{{Key: []byte("traceparent"), Value: []byte("00-trace-span-01")}, nil},
+		}
+
+		err := d.Send(msg, errors.New("json.Unmarshal: invalid character"))
+		require.NoError(t, err)
+
+		require.Len(t, producer.sent, 1)
+		sent := producer.sent[0]
+		assert.Equal(t, "loms.order-events.dlq", sent.Topic)
+		assert.Equal(t, "10", encode(t, sent.Key))
+		assert.Equal(t, "not json", encode(t, sent.Value))
+		assert.Equal(t, map[string]string{
+			"traceparent":            "00-trace-span-01",
+			HeaderDLQError:           "json.Unmarshal: invalid character",
+			HeaderDLQSourceTopic:     "loms.order-events",
+			HeaderDLQSourcePartition: "2",
+			HeaderDLQSourceOffset:    "42",
+		}, headersMap(sent.Headers))
+	})
+
+	t.Run("сообщение без ключа отправляется без ключа", func(t *testing.T) {
+		t.Parallel()
+
+		producer := &fakeProducer{}
+		d := &DeadLetterTopicKafka{producer: producer, topic: "loms.order-events.dlq"}
+
+		err := d.Send(&sarama.ConsumerMessage{Topic: "loms.order-events", Value: []byte("{}")}, errors.New("bad event"))
+		require.NoError(t, err)
+
+		require.Len(t, producer.sent, 1)
+		assert.Nil(t, producer.sent[0].Key)
+	})
+
+	t.Run("ошибка продюсера возвращается", func(t *testing.T) {
+		t.Parallel()
+
+		errBroker := errors.New("broker unavailable")
+		d := &DeadLetterTopicKafka{producer: &fakeProducer{err: errBroker}, topic: "loms.order-events.dlq"}
+
+		err := d.Send(&sarama.ConsumerMessage{Topic: "loms.order-events"}, errors.New("bad event"))
+		require.ErrorIs(t, err, errBroker)
+	})
+}
+
+func TestReplayHandler_ConsumeClaim(t *testing.T) {
+	t.Parallel()
+
+	t.Run("сообщения переотправляются без DLQ-заголовков до конца топика на момент запуска", func(t *testing.T) {
+		t.Parallel()
+
+		producer := &fakeProducer{}
+		done := false
+		h := &replayHandler{producer: producer, targetTopic: "loms.order-events", done: func() { done = true }}
+		h.pending.Store(1)
+		sess := &fakeSession{}
+		msgs := []*sarama.ConsumerMessage{deadLetterMessage(0), deadLetterMessage(1), deadLetterMessage(2)}
+		claim := &fakeReplayClaim{fakeClaim: newFakeClaim(msgs...), highWaterMark: 2}
+
+		err := h.ConsumeClaim(sess, claim)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 2, h.replayed.Load())
+		assert.Equal(t, msgs[:2], sess.marked)
+		assert.True(t, done)
+		require.Len(t, producer.sent, 2)
+		for _, sent := range producer.sent {
+			assert.Equal(t, "loms.order-events", sent.Topic)
+			assert.Equal(t, "10", encode(t, sent.Key))
+			assert.Equal(t, "not json", encode(t, sent.Value))
+			assert.Equal(t, map[string]string{"traceparent": "00-trace-span-01"}, headersMap(sent.Headers))
+		}
+	})
+
+	t.Run("пустой DLQ не вычитывается", func(t *testing.T) {
+		t.Parallel()
+
+		producer := &fakeProducer{}
+		done := false
+		h := &replayHandler{producer: producer, targetTopic: "loms.order-events", done: func() { done = true }}
+		h.pending.Store(1)
+		claim := &fakeReplayClaim{fakeClaim: newFakeClaim(deadLetterMessage(5)), initialOffset: 5, highWaterMark: 5}
+
+		err := h.ConsumeClaim(&fakeSession{}, claim)
+		require.NoError(t, err)
+
+		assert.Empty(t, producer.sent)
+		assert.True(t, done)
+	})
+
+	t.Run("ошибка отправки останавливает переотправку без коммита сообщения", func(t *testing.T) {
+		t.Parallel()
+
+		errBroker := errors.New("broker unavailable")
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		h := &replayHandler{producer: &fakeProducer{err: errBroker}, targetTopic: "loms.order-events", done: cancel}
+		h.pending.Store(2)
+		sess := &fakeSession{}
+		claim := &fakeReplayClaim{fakeClaim: newFakeClaim(deadLetterMessage(0), deadLetterMessage(1)), highWaterMark: 2}
+
+		err := h.ConsumeClaim(sess, claim)
+		require.ErrorIs(t, err, errBroker)
+
+		require.ErrorIs(t, h.err, errBroker)
+		assert.Zero(t, h.replayed.Load())
+		assert.Empty(t, sess.marked)
+		assert.Error(t, ctx.Err(), "replay is stopped")
+	})
+}
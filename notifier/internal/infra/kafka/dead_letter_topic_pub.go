@@ -0,0 +1,85 @@
+package kafka
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/IBM/sarama"
+)
+
+// Заголовки, которые добавляются к сообщению при отправке в DLQ.
+const (
+	HeaderDLQError           = "dlq-error"
+	HeaderDLQSourceTopic     = "dlq-source-topic"
+	HeaderDLQSourcePartition = "dlq-source-partition"
+	HeaderDLQSourceOffset    = "dlq-source-offset"
+)
+
+// DeadLetterTopicKafka реализует отправку необработанных сообщений в DLQ-топик Kafka.
+type DeadLetterTopicKafka struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+// NewDeadLetterTopicKafka создает новый экземпляр DeadLetterTopicKafka.
+func NewDeadLetterTopicKafka(brokers []string, topic string) (*DeadLetterTopicKafka, error) {
+	d := &DeadLetterTopicKafka{
+		topic: topic,
+	}
+
+	var err error
+	d.producer, err = sarama.NewSyncProducer(brokers, newProducerConfig())
+	if err != nil {
+		return nil, fmt.Errorf("sarama.NewSyncProducer: %w", err)
+	}
+
+	return d, nil
+}
+
+// Send отправляет исходное сообщение в DLQ, сохраняя его ключ и заголовки и добавляя причину ошибки.
+func (d *DeadLetterTopicKafka) Send(msg *sarama.ConsumerMessage, reason error) error {
+	headers := make([]sarama.RecordHeader, 0, len(msg.Headers)+4)
+	for _, h := range msg.Headers {
+		if h == nil {
+			continue
+		}
+		headers = append(headers, *h)
+	}
+
+	headers = append(headers,
+		sarama.RecordHeader{Key: []byte(HeaderDLQError), Value: []byte(reason.Error())},
+		sarama.RecordHeader{Key: []byte(HeaderDLQSourceTopic), Value: []byte(msg.Topic)},
+		sarama.RecordHeader{Key: []byte(HeaderDLQSourcePartition), Value: []byte(strconv.FormatInt(int64(msg.Partition), 10))},
+		sarama.RecordHeader{Key: []byte(HeaderDLQSourceOffset), Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+	)
+
+	dlqMsg := &sarama.ProducerMessage{
+		Topic:   d.topic,
+		Value:   sarama.ByteEncoder(msg.Value),
+		Headers: headers,
+	}
+	if msg.Key != nil {
+		dlqMsg.Key = sarama.ByteEncoder(msg.Key)
+	}
+
+	_, _, err := d.producer.SendMessage(dlqMsg)
+	if err != nil {
+		return fmt.Errorf("producer.SendMessage: %w", err)
+	}
+
+	return nil
+}
+
+// Close закрывает продюсера.
+func (d *DeadLetterTopicKafka) Close() error {
+	return d.producer.Close()
+}
+
+func newProducerConfig() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = false
+	config.Producer.Return.Successes = true
+
+	return config
+}
@@ -2,6 +2,7 @@ package kafka
 
 // OrderEventKafka описывает событие по заказу для kafka.
 type OrderEventKafka struct {
+	EventID int64  `json:"event_id"`
 	OrderID int64  `json:"order_id"`
 	Status  string `json:"status"`
 	Moment  string `json:"moment"`
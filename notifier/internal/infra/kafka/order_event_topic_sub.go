@@ -5,32 +5,57 @@ import (
 	"encoding/json"
 	"fmt"
 	"route256/cart/pkg/logger"
+	"route256/cart/pkg/tracer"
 	"route256/notifier/internal/domain"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// RetryConfig задает параметры повторной обработки события.
+type RetryConfig struct {
+	MaxRetries int
+	Backoff    time.Duration
+}
+
 // OrderEventTopicSubKafka реализует подписку на события заказа через Kafka.
 type OrderEventTopicSubKafka struct {
 	consumerGroup  sarama.ConsumerGroup
 	topics         []string
 	eventProcessor orderEventProcessor
+	deadLetter     deadLetterSender
+	retry          RetryConfig
+	tm             *tracer.Manager
 }
 
 type orderEventProcessor interface {
-	Process(event *domain.OrderEvent)
+	Process(ctx context.Context, event *domain.OrderEvent) error
+}
+
+type deadLetterSender interface {
+	Send(msg *sarama.ConsumerMessage, reason error) error
 }
 
 // NewOrderEventTopicSubKafka создает новый экземпляр OrderEventTopicSubKafka.
-func NewOrderEventTopicSubKafka(groupID string, topics []string, brokers []string, eventProcessor orderEventProcessor) (*OrderEventTopicSubKafka, error) {
+func NewOrderEventTopicSubKafka(groupID string, topics []string, brokers []string, eventProcessor orderEventProcessor, deadLetter deadLetterSender, retry RetryConfig, tm *tracer.Manager) (*OrderEventTopicSubKafka, error) {
 	o := &OrderEventTopicSubKafka{
 		topics:         topics,
 		eventProcessor: eventProcessor,
+		deadLetter:     deadLetter,
+		retry:          retry,
+		tm:             tm,
 	}
 
 	config := sarama.NewConfig()
 	config.Consumer.Offsets.Initial = sarama.OffsetOldest
-	config.Consumer.Offsets.AutoCommit.Enable = true
+	config.Consumer.Offsets.AutoCommit.Enable = false
 
 	var err error
 	o.consumerGroup, err = sarama.NewConsumerGroup(brokers, groupID, config)
@@ -41,61 +66,226 @@ func NewOrderEventTopicSubKafka(groupID string, topics []string, brokers []strin
 	return o, nil
 }
 
+// Subscription управляет запущенной подпиской на события заказа.
+type Subscription struct {
+	stopConsume   context.CancelFunc
+	cancelProcess context.CancelFunc
+	done          chan struct{}
+}
+
 // Start запускает обработку событий заказа.
-func (o *OrderEventTopicSubKafka) Start(ctx context.Context) {
+// Чтение новых сообщений прекращается при отмене ctx или вызове Subscription.Stop.
+func (o *OrderEventTopicSubKafka) Start(ctx context.Context) *Subscription {
+	consumeCtx, stopConsume := context.WithCancel(ctx)
+	// Обработка уже полученного сообщения не прерывается вместе с чтением,
+	// чтобы при остановке оно было обработано и закоммичено.
+	processCtx, cancelProcess := context.WithCancel(context.WithoutCancel(ctx))
+
+	s := &Subscription{
+		stopConsume:   stopConsume,
+		cancelProcess: cancelProcess,
+		done:          make(chan struct{}),
+	}
+
 	cons := &consumer{
+		processCtx:     processCtx,
 		eventProcessor: o.eventProcessor,
+		deadLetter:     o.deadLetter,
+		retry:          o.retry,
+		tm:             o.tm,
 	}
 
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
 	go func() {
+		defer wg.Done()
+
 		for err := range o.consumerGroup.Errors() {
 			logger.Errorw("ConsumerGroup error", "err", err)
 		}
 	}()
 
 	go func() {
+		defer wg.Done()
 		defer o.consumerGroup.Close()
 
 		for {
-			err := o.consumerGroup.Consume(ctx, o.topics, cons)
+			err := o.consumerGroup.Consume(consumeCtx, o.topics, cons)
 			if err != nil {
 				logger.Errorw("consumerGroup.Consume error", "err", err)
 			}
 
-			if ctx.Err() != nil {
+			if consumeCtx.Err() != nil {
 				break
 			}
 		}
 	}()
+
+	go func() {
+		wg.Wait()
+		cancelProcess()
+		close(s.done)
+	}()
+
+	return s
+}
+
+// Stop прекращает чтение новых сообщений и ждет, пока текущие сообщения будут обработаны
+// и их оффсеты закоммичены. Если ctx завершится раньше, обработка прерывается.
+func (s *Subscription) Stop(ctx context.Context) error {
+	s.stopConsume()
+
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		s.cancelProcess()
+		<-s.done
+		return ctx.Err()
+	}
+}
+
+// Done возвращает канал, который закрывается после полной остановки подписки.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
 }
 
 type consumer struct {
+	processCtx     context.Context
+	tm             *tracer.Manager
 	eventProcessor orderEventProcessor
+	deadLetter     deadLetterSender
+	retry          RetryConfig
 }
 
 func (c *consumer) Setup(sarama.ConsumerGroupSession) error   { return nil }
 func (c *consumer) Cleanup(sarama.ConsumerGroupSession) error { return nil }
 
+// ConsumeClaim обрабатывает сообщения партиции и коммитит оффсет только после успешной обработки
+// или отправки сообщения в DLQ. При ошибке сессия завершается, и сообщение будет прочитано повторно.
 func (c *consumer) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	if sess.Context().Err() != nil {
 		return nil
 	}
 
-	for msg := range claim.Messages() {
-		var orderEvent OrderEventKafka
-		err := json.Unmarshal(msg.Value, &orderEvent)
-		if err != nil {
-			logger.Errorw("can't unmarshall kafka message to json", "err", err, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
-			continue
+	for {
+		select {
+		case <-sess.Context().Done():
+			return nil
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+
+			err := c.handleMessage(c.processCtx, msg)
+			if err != nil {
+				if c.processCtx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+
+			sess.MarkMessage(msg, "")
+			sess.Commit()
+		}
+	}
+}
+
+// handleMessage обрабатывает сообщение в спане, продолжающем трейс из заголовков W3C Trace Context.
+func (c *consumer) handleMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headersCarrier(msg.Headers))
+
+	ctx, span := c.tm.Tracer.Start(ctx, fmt.Sprintf("process %s", msg.Topic),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeProcess,
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingDestinationPartitionID(strconv.Itoa(int(msg.Partition))),
+			semconv.MessagingKafkaOffset(int(msg.Offset)),
+			semconv.MessagingKafkaMessageKey(string(msg.Key)),
+		),
+	)
+	defer span.End()
+
+	err := c.processMessage(ctx, msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return err
+}
+
+func (c *consumer) processMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	var orderEvent OrderEventKafka
+	err := json.Unmarshal(msg.Value, &orderEvent)
+	if err != nil {
+		logger.Errorw("can't unmarshall kafka message to json", "err", err, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+		return c.sendToDeadLetter(msg, fmt.Errorf("json.Unmarshal: %w", err))
+	}
+
+	err = c.processWithRetries(ctx, &domain.OrderEvent{
+		EventID: orderEvent.EventID,
+		OrderID: orderEvent.OrderID,
+		Status:  orderEvent.Status,
+		Moment:  orderEvent.Moment,
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
 		}
 
-		c.eventProcessor.Process(&domain.OrderEvent{
-			OrderID: orderEvent.OrderID,
-			Status:  orderEvent.Status,
-			Moment:  orderEvent.Moment,
-		})
+		logger.Errorw("can't process order event", "err", err, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+		return c.sendToDeadLetter(msg, fmt.Errorf("eventProcessor.Process: %w", err))
+	}
+
+	return nil
+}
 
-		sess.MarkMessage(msg, "")
+// processWithRetries вызывает обработчик события, повторяя попытку не более MaxRetries раз
+// с линейно растущей задержкой.
+func (c *consumer) processWithRetries(ctx context.Context, event *domain.OrderEvent) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = c.eventProcessor.Process(ctx, event)
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= c.retry.MaxRetries {
+			return err
+		}
+
+		logger.Warnw("order event processing failed, retrying", "err", err, "order_id", event.OrderID, "attempt", attempt+1)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.retry.Backoff * time.Duration(attempt+1)):
+		}
 	}
+}
+
+// sendToDeadLetter отправляет сообщение, которое не удалось обработать, в DLQ.
+func (c *consumer) sendToDeadLetter(msg *sarama.ConsumerMessage, reason error) error {
+	err := c.deadLetter.Send(msg, reason)
+	if err != nil {
+		logger.Errorw("can't send kafka message to dead letter topic", "err", err, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+		return fmt.Errorf("deadLetter.Send: %w", err)
+	}
+
+	logger.Warnw("kafka message sent to dead letter topic", "reason", reason, "topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
 	return nil
 }
+
+// headersCarrier собирает заголовки сообщения для извлечения контекста трассировки.
+func headersCarrier(headers []*sarama.RecordHeader) propagation.MapCarrier {
+	carrier := make(propagation.MapCarrier, len(headers))
+	for _, h := range headers {
+		carrier[string(h.Key)] = string(h.Value)
+	}
+
+	return carrier
+}
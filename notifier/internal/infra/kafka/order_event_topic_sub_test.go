@@ -0,0 +1,196 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"route256/cart/pkg/logger"
+	"route256/cart/pkg/tracer"
+	"route256/notifier/internal/domain"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLogger(&logger.Config{Level: zap.FatalLevel})
+	goleak.VerifyTestMain(m)
+}
+
+type fakeProcessor struct {
+	mx     sync.Mutex
+	events []*domain.OrderEvent
+	errs   []error
+}
+
+func (p *fakeProcessor) Process(_ context.Context, event *domain.OrderEvent) error {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	attempt := len(p.events)
+	p.events = append(p.events, event)
+	if attempt < len(p.errs) {
+		return p.errs[attempt]
+	}
+
+	return nil
+}
+
+type fakeDeadLetter struct {
+	sent []*sarama.ConsumerMessage
+	err  error
+}
+
+func (d *fakeDeadLetter) Send(msg *sarama.ConsumerMessage, _ error) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.sent = append(d.sent, msg)
+
+	return nil
+}
+
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	marked  []*sarama.ConsumerMessage
+	commits int
+}
+
+func (s *fakeSession) Context() context.Context { return context.Background() }
+
+func (s *fakeSession) MarkMessage(msg *sarama.ConsumerMessage, _ string) {
+	s.marked = append(s.marked, msg)
+}
+
+func (s *fakeSession) Commit() { s.commits++ }
+
+type fakeClaim struct {
+	sarama.ConsumerGroupClaim
+	messages chan *sarama.ConsumerMessage
+}
+
+func (c *fakeClaim) Messages() <-chan *sarama.ConsumerMessage { return c.messages }
+
+func newFakeClaim(msgs ...*sarama.ConsumerMessage) *fakeClaim {
+	c := &fakeClaim{messages: make(chan *sarama.ConsumerMessage, len(msgs))}
+	for _, msg := range msgs {
+		c.messages <- msg
+	}
+	close(c.messages)
+
+	return c
+}
+
+func newTestConsumer(processor orderEventProcessor, deadLetter deadLetterSender, maxRetries int) *consumer {
+	return &consumer{
+		processCtx:     context.Background(),
+		tm:             &tracer.Manager{Tracer: noop.NewTracerProvider().Tracer("test")},
+		eventProcessor: processor,
+		deadLetter:     deadLetter,
+		retry:          RetryConfig{MaxRetries: maxRetries, Backoff: time.Millisecond},
+	}
+}
+
+func orderEventMessage(offset int64) *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Topic:  "loms.order-events",
+		Offset: offset,
+		Key:    []byte("10"),
+		Value:  []byte(`{"event_id":7,"order_id":10,"status":"paid","moment":"2025-01-01T10:00:00Z"}`),
+	}
+}
+
+func TestConsumer_ConsumeClaim(t *testing.T) {
+	t.Parallel()
+
+	errProcess := errors.New("loms unavailable")
+
+	t.Run("оффсет коммитится после успешной обработки", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &fakeProcessor{}
+		deadLetter := &fakeDeadLetter{}
+		sess := &fakeSession{}
+		msgs := []*sarama.ConsumerMessage{orderEventMessage(1), orderEventMessage(2)}
+
+		err := newTestConsumer(processor, deadLetter, 0).ConsumeClaim(sess, newFakeClaim(msgs...))
+		require.NoError(t, err)
+
+		assert.Equal(t, msgs, sess.marked)
+		assert.Equal(t, 2, sess.commits)
+		assert.Empty(t, deadLetter.sent)
+		require.Len(t, processor.events, 2)
+		assert.Equal(t, &domain.OrderEvent{EventID: 7, OrderID: 10, Status: "paid", Moment: "2025-01-01T10:00:00Z"}, processor.events[0])
+	})
+
+	t.Run("временная ошибка обработки повторяется", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &fakeProcessor{errs: []error{errProcess, errProcess}}
+		deadLetter := &fakeDeadLetter{}
+		sess := &fakeSession{}
+
+		err := newTestConsumer(processor, deadLetter, 2).ConsumeClaim(sess, newFakeClaim(orderEventMessage(1)))
+		require.NoError(t, err)
+
+		assert.Len(t, processor.events, 3)
+		assert.Empty(t, deadLetter.sent)
+		assert.Len(t, sess.marked, 1)
+		assert.Equal(t, 1, sess.commits)
+	})
+
+	t.Run("после исчерпания повторов сообщение уходит в DLQ и коммитится", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &fakeProcessor{errs: []error{errProcess, errProcess, errProcess, errProcess}}
+		deadLetter := &fakeDeadLetter{}
+		sess := &fakeSession{}
+		msg := orderEventMessage(1)
+
+		err := newTestConsumer(processor, deadLetter, 2).ConsumeClaim(sess, newFakeClaim(msg))
+		require.NoError(t, err)
+
+		assert.Len(t, processor.events, 3)
+		assert.Equal(t, []*sarama.ConsumerMessage{msg}, deadLetter.sent)
+		assert.Equal(t, []*sarama.ConsumerMessage{msg}, sess.marked)
+		assert.Equal(t, 1, sess.commits)
+	})
+
+	t.Run("нераспознанное сообщение уходит в DLQ без обработки", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &fakeProcessor{}
+		deadLetter := &fakeDeadLetter{}
+		sess := &fakeSession{}
+		msg := &sarama.ConsumerMessage{Topic: "loms.order-events", Offset: 1, Value: []byte("not json")}
+
+		err := newTestConsumer(processor, deadLetter, 2).ConsumeClaim(sess, newFakeClaim(msg))
+		require.NoError(t, err)
+
+		assert.Empty(t, processor.events)
+		assert.Equal(t, []*sarama.ConsumerMessage{msg}, deadLetter.sent)
+		assert.Equal(t, 1, sess.commits)
+	})
+
+	t.Run("оффсет не коммитится, если DLQ недоступна", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &fakeProcessor{errs: []error{errProcess}}
+		deadLetter := &fakeDeadLetter{err: errors.New("broker unavailable")}
+		sess := &fakeSession{}
+
+		err := newTestConsumer(processor, deadLetter, 0).ConsumeClaim(sess, newFakeClaim(orderEventMessage(1), orderEventMessage(2)))
+		require.Error(t, err)
+
+		assert.Len(t, processor.events, 1)
+		assert.Empty(t, sess.marked)
+		assert.Zero(t, sess.commits)
+	})
+}
@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"route256/notifier/internal/domain"
+	"time"
+)
+
+// EmailChannelName имя канала email-уведомлений.
+const EmailChannelName = domain.ChannelEmail
+
+// EmailChannel реализует отправку уведомлений по email через SMTP.
+type EmailChannel struct {
+	host    string
+	addr    string
+	from    string
+	auth    smtp.Auth
+	timeout time.Duration
+}
+
+// NewEmailChannel создает новый экземпляр EmailChannel.
+// Если username пустой, аутентификация на SMTP-сервере не выполняется.
+func NewEmailChannel(host, port, from, username, password string, timeout time.Duration) *EmailChannel {
+	e := &EmailChannel{
+		host:    host,
+		addr:    net.JoinHostPort(host, port),
+		from:    from,
+		timeout: timeout,
+	}
+
+	if username != "" {
+		e.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return e
+}
+
+// Name возвращает имя канала.
+func (e *EmailChannel) Name() string {
+	return EmailChannelName
+}
+
+// Send отправляет уведомление на email пользователя.
+func (e *EmailChannel) Send(ctx context.Context, contact *domain.Contact, n *domain.Notification) error {
+	if contact.Email == "" {
+		return domain.ErrNoChannelAddress
+	}
+
+	dialer := &net.Dialer{Timeout: e.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", e.addr)
+	if err != nil {
+		return fmt.Errorf("dialer.DialContext: %w", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(e.timeout)
+	}
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("conn.SetDeadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, e.host)
+	if err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp.NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: e.host, MinVersion: tls.VersionTLS12})
+		if err != nil {
+			return fmt.Errorf("client.StartTLS: %w", err)
+		}
+	}
+
+	if e.auth != nil {
+		err = client.Auth(e.auth)
+		if err != nil {
+			return fmt.Errorf("client.Auth: %w", err)
+		}
+	}
+
+	err = client.Mail(e.from)
+	if err != nil {
+		return fmt.Errorf("client.Mail: %w", err)
+	}
+
+	err = client.Rcpt(contact.Email)
+	if err != nil {
+		return fmt.Errorf("client.Rcpt: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("client.Data: %w", err)
+	}
+
+	_, err = w.Write(e.buildMessage(contact.Email, n))
+	if err != nil {
+		_ = w.Close()
+		return fmt.Errorf("data.Write: %w", err)
+	}
+
+	err = w.Close()
+	if err != nil {
+		return fmt.Errorf("data.Close: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (e *EmailChannel) buildMessage(to string, n *domain.Notification) []byte {
+	var msg bytes.Buffer
+
+	fmt.Fprintf(&msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", n.Subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(n.Body)
+
+	return msg.Bytes()
+}
@@ -0,0 +1,169 @@
+package notification
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"route256/notifier/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTPServer минимальный SMTP-сервер, сохраняющий принятые письма.
+type fakeSMTPServer struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mx   sync.Mutex
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTPServer(t *testing.T) *fakeSMTPServer {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	s := &fakeSMTPServer{listener: l}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			s.serve(conn)
+		}
+	}()
+
+	t.Cleanup(func() {
+		_ = l.Close()
+		s.wg.Wait()
+	})
+
+	return s
+}
+
+func (s *fakeSMTPServer) hostPort() (string, string) {
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	return host, port
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	reply("220 fake smtp")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		upper := strings.ToUpper(cmd)
+
+		switch {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 fake")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			s.mx.Lock()
+			s.from = strings.Trim(cmd[len("MAIL FROM:"):], "<>")
+			s.mx.Unlock()
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			s.mx.Lock()
+			s.to = append(s.to, strings.Trim(cmd[len("RCPT TO:"):], "<>"))
+			s.mx.Unlock()
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 go ahead")
+			var data strings.Builder
+			for {
+				dl, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dl == ".\r\n" {
+					break
+				}
+				data.WriteString(dl)
+			}
+			s.mx.Lock()
+			s.data = data.String()
+			s.mx.Unlock()
+			reply("250 OK")
+		case upper == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func TestEmailChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	notification := &domain.Notification{
+		OrderID: 10,
+		Status:  "paid",
+		Subject: "Заказ №10 оплачен",
+		Body:    "Заказ №10 успешно оплачен.",
+	}
+
+	t.Run("успешная отправка", func(t *testing.T) {
+		t.Parallel()
+
+		server := newFakeSMTPServer(t)
+		host, port := server.hostPort()
+		channel := NewEmailChannel(host, port, "noreply@route256.local", "", "", time.Second)
+
+		err := channel.Send(context.Background(), &domain.Contact{UserID: 1, Email: "user@route256.local"}, notification)
+
+		require.NoError(t, err)
+
+		server.mx.Lock()
+		defer server.mx.Unlock()
+		assert.Equal(t, "noreply@route256.local", server.from)
+		assert.Equal(t, []string{"user@route256.local"}, server.to)
+		assert.Contains(t, server.data, "To: user@route256.local")
+		assert.Contains(t, server.data, "Заказ №10 успешно оплачен.")
+	})
+
+	t.Run("SMTP-сервер недоступен", func(t *testing.T) {
+		t.Parallel()
+
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		host, port, _ := net.SplitHostPort(l.Addr().String())
+		require.NoError(t, l.Close())
+
+		channel := NewEmailChannel(host, port, "noreply@route256.local", "", "", time.Second)
+
+		err = channel.Send(context.Background(), &domain.Contact{UserID: 1, Email: "user@route256.local"}, notification)
+
+		require.Error(t, err)
+	})
+
+	t.Run("у пользователя нет email", func(t *testing.T) {
+		t.Parallel()
+
+		channel := NewEmailChannel("127.0.0.1", "25", "noreply@route256.local", "", "", time.Second)
+
+		err := channel.Send(context.Background(), &domain.Contact{UserID: 1}, notification)
+
+		require.ErrorIs(t, err, domain.ErrNoChannelAddress)
+	})
+}
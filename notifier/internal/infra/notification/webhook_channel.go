@@ -0,0 +1,82 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"route256/notifier/internal/domain"
+)
+
+// WebhookChannelName имя канала webhook-уведомлений.
+const WebhookChannelName = domain.ChannelWebhook
+
+// HTTPClient определяет методы для выполнения HTTP-запросов.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// WebhookChannel реализует отправку уведомлений на HTTP webhook пользователя.
+type WebhookChannel struct {
+	client HTTPClient
+}
+
+// NewWebhookChannel создает новый экземпляр WebhookChannel.
+func NewWebhookChannel(client HTTPClient) *WebhookChannel {
+	return &WebhookChannel{
+		client: client,
+	}
+}
+
+type webhookPayload struct {
+	UserID  int64  `json:"user_id"`
+	OrderID int64  `json:"order_id"`
+	Status  string `json:"status"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Name возвращает имя канала.
+func (w *WebhookChannel) Name() string {
+	return WebhookChannelName
+}
+
+// Send отправляет уведомление POST-запросом на webhook пользователя.
+func (w *WebhookChannel) Send(ctx context.Context, contact *domain.Contact, n *domain.Notification) error {
+	if contact.WebhookURL == "" {
+		return domain.ErrNoChannelAddress
+	}
+
+	body, err := json.Marshal(&webhookPayload{
+		UserID:  contact.UserID,
+		OrderID: n.OrderID,
+		Status:  n.Status,
+		Subject: n.Subject,
+		Body:    n.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, contact.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook ответил статусом %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"route256/notifier/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func TestWebhookChannel_Send(t *testing.T) {
+	t.Parallel()
+
+	notification := &domain.Notification{
+		OrderID: 10,
+		Status:  "paid",
+		Subject: "Заказ №10 оплачен",
+		Body:    "Заказ №10 успешно оплачен.",
+	}
+
+	t.Run("успешная отправка", func(t *testing.T) {
+		t.Parallel()
+
+		var got webhookPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+		channel := NewWebhookChannel(client)
+
+		err := channel.Send(context.Background(), &domain.Contact{UserID: 1, WebhookURL: server.URL}, notification)
+
+		require.NoError(t, err)
+		assert.Equal(t, webhookPayload{
+			UserID:  1,
+			OrderID: 10,
+			Status:  "paid",
+			Subject: "Заказ №10 оплачен",
+			Body:    "Заказ №10 успешно оплачен.",
+		}, got)
+	})
+
+	t.Run("webhook вернул ошибку", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+		channel := NewWebhookChannel(client)
+
+		err := channel.Send(context.Background(), &domain.Contact{UserID: 1, WebhookURL: server.URL}, notification)
+
+		require.Error(t, err)
+	})
+
+	t.Run("у пользователя нет webhook", func(t *testing.T) {
+		t.Parallel()
+
+		channel := NewWebhookChannel(http.DefaultClient)
+
+		err := channel.Send(context.Background(), &domain.Contact{UserID: 1}, notification)
+
+		require.ErrorIs(t, err, domain.ErrNoChannelAddress)
+	})
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"route256/notifier/internal/domain"
+)
+
+// ContactRepositoryInMemory хранит контакты пользователей в in-memory хранилище.
+type ContactRepositoryInMemory struct {
+	storage map[int64]*domain.Contact
+}
+
+// NewContactRepositoryInMemory создает новый репозиторий контактов с заранее заданными контактами.
+func NewContactRepositoryInMemory(contacts []*domain.Contact) *ContactRepositoryInMemory {
+	r := &ContactRepositoryInMemory{
+		storage: make(map[int64]*domain.Contact, len(contacts)),
+	}
+
+	for _, c := range contacts {
+		r.storage[c.UserID] = c
+	}
+
+	return r
+}
+
+// GetContactByUserID возвращает контакты пользователя по его ID.
+func (r *ContactRepositoryInMemory) GetContactByUserID(_ context.Context, userID int64) (*domain.Contact, error) {
+	contact, ok := r.storage[userID]
+	if !ok {
+		return nil, domain.ErrContactNotFound
+	}
+
+	return contact, nil
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"route256/notifier/internal/domain"
+	sqlcrepos "route256/notifier/internal/infra/repository/postgres/sqlc/generated"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DeferredNotificationRepository хранит в postgres уведомления, отложенные до окончания тихих часов.
+type DeferredNotificationRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewDeferredNotificationRepository создает новый DeferredNotificationRepository.
+func NewDeferredNotificationRepository(pool *pgxpool.Pool) *DeferredNotificationRepository {
+	return &DeferredNotificationRepository{
+		pool: pool,
+	}
+}
+
+// Save сохраняет отложенное уведомление. Если уведомление о событии уже отложено,
+// обновляется только время отправки.
+func (r *DeferredNotificationRepository) Save(ctx context.Context, n *domain.DeferredNotification) error {
+	querier := sqlcrepos.New(r.pool)
+	err := querier.UpsertDeferredNotification(ctx, &sqlcrepos.UpsertDeferredNotificationParams{
+		EventKey:  n.Event.DedupKey(),
+		EventID:   n.Event.EventID,
+		OrderID:   n.Event.OrderID,
+		Status:    n.Event.Status,
+		Moment:    n.Event.Moment,
+		SendAfter: pgtype.Timestamp{Time: n.SendAfter.UTC(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("querier.UpsertDeferredNotification: %w", err)
+	}
+
+	return nil
+}
+
+// GetDue возвращает не более limit уведомлений, время отправки которых наступило к моменту now.
+func (r *DeferredNotificationRepository) GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.DeferredNotification, error) {
+	querier := sqlcrepos.New(r.pool)
+	rows, err := querier.GetDueDeferredNotifications(ctx, &sqlcrepos.GetDueDeferredNotificationsParams{
+		SendAfter: pgtype.Timestamp{Time: now.UTC(), Valid: true},
+		Limit:     int32(limit), //nolint:gosec // G115: размер пачки задается в конфиге
+	})
+	if err != nil {
+		return nil, fmt.Errorf("querier.GetDueDeferredNotifications: %w", err)
+	}
+
+	notifications := make([]*domain.DeferredNotification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, &domain.DeferredNotification{
+			Event: &domain.OrderEvent{
+				EventID: row.EventID,
+				OrderID: row.OrderID,
+				Status:  row.Status,
+				Moment:  row.Moment,
+			},
+			SendAfter: row.SendAfter.Time,
+		})
+	}
+
+	return notifications, nil
+}
+
+// Delete удаляет отложенное уведомление о событии с данным ключом.
+func (r *DeferredNotificationRepository) Delete(ctx context.Context, key string) error {
+	querier := sqlcrepos.New(r.pool)
+	err := querier.DeleteDeferredNotification(ctx, key)
+	if err != nil {
+		return fmt.Errorf("querier.DeleteDeferredNotification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"route256/notifier/internal/domain"
+	sqlcrepos "route256/notifier/internal/infra/repository/postgres/sqlc/generated"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PreferencesRepository предоставляет доступ к настройкам уведомлений пользователей в postgres.
+type PreferencesRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPreferencesRepository создает новый PreferencesRepository.
+func NewPreferencesRepository(pool *pgxpool.Pool) *PreferencesRepository {
+	return &PreferencesRepository{
+		pool: pool,
+	}
+}
+
+// GetByUserID возвращает настройки уведомлений пользователя из postgres.
+func (r *PreferencesRepository) GetByUserID(ctx context.Context, userID int64) (*domain.NotificationPreferences, error) {
+	querier := sqlcrepos.New(r.pool)
+	row, err := querier.GetPreferencesByUserID(ctx, userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, domain.ErrPreferencesNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("querier.GetPreferencesByUserID: %w", err)
+	}
+
+	prefs := &domain.NotificationPreferences{
+		UserID:   row.UserID,
+		Channels: row.Channels,
+		Statuses: row.Statuses,
+	}
+
+	if row.QuietHoursStart.Valid && row.QuietHoursEnd.Valid {
+		prefs.QuietHours = &domain.QuietHours{
+			Start:    time.Duration(row.QuietHoursStart.Microseconds) * time.Microsecond,
+			End:      time.Duration(row.QuietHoursEnd.Microseconds) * time.Microsecond,
+			Timezone: row.Timezone,
+		}
+	}
+
+	return prefs, nil
+}
+
+// Upsert сохраняет настройки уведомлений пользователя в postgres.
+func (r *PreferencesRepository) Upsert(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	params := &sqlcrepos.UpsertPreferencesParams{
+		UserID:    prefs.UserID,
+		Channels:  nonNil(prefs.Channels),
+		Statuses:  nonNil(prefs.Statuses),
+		Timezone:  time.UTC.String(),
+		UpdatedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	}
+
+	if prefs.QuietHours != nil {
+		params.QuietHoursStart = pgtype.Time{Microseconds: prefs.QuietHours.Start.Microseconds(), Valid: true}
+		params.QuietHoursEnd = pgtype.Time{Microseconds: prefs.QuietHours.End.Microseconds(), Valid: true}
+		params.Timezone = prefs.QuietHours.Timezone
+	}
+
+	querier := sqlcrepos.New(r.pool)
+	err := querier.UpsertPreferences(ctx, params)
+	if err != nil {
+		return fmt.Errorf("querier.UpsertPreferences: %w", err)
+	}
+
+	return nil
+}
+
+// nonNil заменяет nil-слайс пустым, чтобы в postgres записался пустой массив, а не NULL.
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
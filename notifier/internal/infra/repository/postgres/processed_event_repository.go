@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	sqlcrepos "route256/notifier/internal/infra/repository/postgres/sqlc/generated"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ProcessedEventRepository хранит ключи обработанных событий в postgres,
+// чтобы дедупликация переживала перезапуски и ребалансировки консьюмера.
+type ProcessedEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewProcessedEventRepository создает новый ProcessedEventRepository.
+func NewProcessedEventRepository(pool *pgxpool.Pool) *ProcessedEventRepository {
+	return &ProcessedEventRepository{
+		pool: pool,
+	}
+}
+
+// IsProcessed проверяет, было ли событие с данным ключом уже обработано.
+func (r *ProcessedEventRepository) IsProcessed(ctx context.Context, key string) (bool, error) {
+	querier := sqlcrepos.New(r.pool)
+	processed, err := querier.IsEventProcessed(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("querier.IsEventProcessed: %w", err)
+	}
+
+	return processed, nil
+}
+
+// MarkProcessed помечает событие с данным ключом как обработанное.
+// Повторная пометка того же ключа ничего не меняет.
+func (r *ProcessedEventRepository) MarkProcessed(ctx context.Context, key string) error {
+	querier := sqlcrepos.New(r.pool)
+	err := querier.MarkEventProcessed(ctx, &sqlcrepos.MarkEventProcessedParams{
+		Key:         key,
+		ProcessedAt: pgtype.Timestamp{Time: time.Now(), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("querier.MarkEventProcessed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+-- name: GetPreferencesByUserID :one
+select *
+from notification_preferences
+where user_id = $1;
+
+-- name: UpsertPreferences :exec
+insert into notification_preferences(user_id, channels, statuses, quiet_hours_start, quiet_hours_end, timezone, updated_at)
+values ($1, $2, $3, $4, $5, $6, $7)
+on conflict (user_id) do update
+set channels          = excluded.channels,
+    statuses          = excluded.statuses,
+    quiet_hours_start = excluded.quiet_hours_start,
+    quiet_hours_end   = excluded.quiet_hours_end,
+    timezone          = excluded.timezone,
+    updated_at        = excluded.updated_at;
+
+-- name: IsEventProcessed :one
+select exists(select 1
+              from processed_events
+              where key = $1);
+
+-- name: MarkEventProcessed :exec
+insert into processed_events(key, processed_at)
+values ($1, $2)
+on conflict (key) do nothing;
+
+-- name: UpsertDeferredNotification :exec
+insert into deferred_notifications(event_key, event_id, order_id, status, moment, send_after)
+values ($1, $2, $3, $4, $5, $6)
+on conflict (event_key) do update
+set send_after = excluded.send_after;
+
+-- name: GetDueDeferredNotifications :many
+select *
+from deferred_notifications
+where send_after <= $1
+order by send_after
+limit $2;
+
+-- name: DeleteDeferredNotification :exec
+delete
+from deferred_notifications
+where event_key = $1;
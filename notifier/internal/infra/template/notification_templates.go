@@ -0,0 +1,79 @@
+package template
+
+import (
+	"bytes"
+	"fmt"
+	"route256/notifier/internal/domain"
+	"text/template"
+)
+
+// StatusTemplate задает текстовые шаблоны темы и тела уведомления для статуса заказа.
+type StatusTemplate struct {
+	Subject string
+	Body    string
+}
+
+type compiledTemplate struct {
+	subject *template.Template
+	body    *template.Template
+}
+
+// NotificationTemplates формирует уведомления по шаблонам в зависимости от статуса заказа.
+type NotificationTemplates struct {
+	templates map[string]*compiledTemplate
+}
+
+// NewNotificationTemplates создает новый экземпляр NotificationTemplates.
+// Шаблоны задаются в синтаксисе text/template, доступны поля EventID, OrderID, Status и Moment.
+func NewNotificationTemplates(templates map[string]StatusTemplate) (*NotificationTemplates, error) {
+	t := &NotificationTemplates{
+		templates: make(map[string]*compiledTemplate, len(templates)),
+	}
+
+	for status, st := range templates {
+		subject, err := template.New(status + "_subject").Option("missingkey=error").Parse(st.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("template.Parse subject for status %q: %w", status, err)
+		}
+
+		body, err := template.New(status + "_body").Option("missingkey=error").Parse(st.Body)
+		if err != nil {
+			return nil, fmt.Errorf("template.Parse body for status %q: %w", status, err)
+		}
+
+		t.templates[status] = &compiledTemplate{
+			subject: subject,
+			body:    body,
+		}
+	}
+
+	return t, nil
+}
+
+// Render формирует уведомление по событию заказа.
+// Если для статуса нет шаблона, возвращает domain.ErrTemplateNotFound.
+func (t *NotificationTemplates) Render(event *domain.OrderEvent) (*domain.Notification, error) {
+	ct, ok := t.templates[event.Status]
+	if !ok {
+		return nil, domain.ErrTemplateNotFound
+	}
+
+	var subject, body bytes.Buffer
+
+	err := ct.subject.Execute(&subject, event)
+	if err != nil {
+		return nil, fmt.Errorf("subject.Execute: %w", err)
+	}
+
+	err = ct.body.Execute(&body, event)
+	if err != nil {
+		return nil, fmt.Errorf("body.Execute: %w", err)
+	}
+
+	return &domain.Notification{
+		OrderID: event.OrderID,
+		Status:  event.Status,
+		Subject: subject.String(),
+		Body:    body.String(),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"route256/cart/pkg/logger"
+)
+
+type deferredSender interface {
+	SendDeferred(ctx context.Context, limit int) error
+}
+
+// DeferredNotificationWorker периодически отправляет уведомления, отложенные до окончания тихих часов.
+type DeferredNotificationWorker struct {
+	sender    deferredSender
+	period    time.Duration
+	batchSize int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDeferredNotificationWorker создает новый экземпляр DeferredNotificationWorker.
+func NewDeferredNotificationWorker(sender deferredSender, period time.Duration, batchSize int) *DeferredNotificationWorker {
+	return &DeferredNotificationWorker{
+		sender:    sender,
+		period:    period,
+		batchSize: batchSize,
+	}
+}
+
+// Start запускает периодическую отправку отложенных уведомлений до отмены ctx или вызова Stop.
+func (w *DeferredNotificationWorker) Start(ctx context.Context) {
+	ctx, w.cancel = context.WithCancel(ctx)
+	w.done = make(chan struct{})
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				err := w.sender.SendDeferred(ctx, w.batchSize)
+				if err != nil {
+					logger.Warnw("error at SendDeferred()", "err", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop останавливает отправку и дожидается завершения текущей итерации.
+func (w *DeferredNotificationWorker) Stop() {
+	w.cancel()
+	<-w.done
+}
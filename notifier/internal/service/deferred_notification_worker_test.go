@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingDeferredSender struct {
+	started  chan struct{}
+	release  chan struct{}
+	finished atomic.Bool
+}
+
+func (s *blockingDeferredSender) SendDeferred(_ context.Context, _ int) error {
+	select {
+	case s.started <- struct{}{}:
+	default:
+	}
+	<-s.release
+	s.finished.Store(true)
+
+	return nil
+}
+
+func TestDeferredNotificationWorker_Stop(t *testing.T) {
+	t.Parallel()
+
+	sender := &blockingDeferredSender{
+		started: make(chan struct{}, 1),
+		release: make(chan struct{}),
+	}
+	worker := NewDeferredNotificationWorker(sender, time.Millisecond, 10)
+	worker.Start(context.Background())
+
+	<-sender.started
+
+	stopped := make(chan struct{})
+	go func() {
+		worker.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatal("Stop returned before the in-flight iteration finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(sender.release)
+	<-stopped
+
+	assert.True(t, sender.finished.Load())
+}
@@ -0,0 +1,30 @@
+package service
+
+import (
+	"context"
+	"route256/loms/pkg/api/orders/v1"
+)
+
+// LomsServiceGRPC реализует доступ к сервису loms по gRPC.
+type LomsServiceGRPC struct {
+	orderClient orders.OrderServiceV1Client
+}
+
+// NewLomsServiceGRPC создает новый клиент сервиса loms.
+func NewLomsServiceGRPC(orderClient orders.OrderServiceV1Client) *LomsServiceGRPC {
+	return &LomsServiceGRPC{
+		orderClient: orderClient,
+	}
+}
+
+// GetOrderUserID возвращает ID пользователя, создавшего заказ.
+func (ls *LomsServiceGRPC) GetOrderUserID(ctx context.Context, orderID int64) (int64, error) {
+	resp, err := ls.orderClient.OrderInfoV1(ctx, &orders.OrderInfoRequest{
+		OrderId: orderID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.UserId, nil
+}
@@ -1,20 +1,239 @@
 package service
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"route256/cart/pkg/logger"
+	"route256/cart/pkg/metrics"
 	"route256/notifier/internal/domain"
+	"time"
 )
 
+// ProcessedEventRepository определяет хранилище ключей уже обработанных событий.
+type ProcessedEventRepository interface {
+	IsProcessed(ctx context.Context, key string) (bool, error)
+	MarkProcessed(ctx context.Context, key string) error
+}
+
+// DeferredNotificationRepository определяет хранилище уведомлений, отложенных до окончания тихих часов.
+type DeferredNotificationRepository interface {
+	Save(ctx context.Context, n *domain.DeferredNotification) error
+	GetDue(ctx context.Context, now time.Time, limit int) ([]*domain.DeferredNotification, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ContactRepository определяет методы для получения контактов пользователя.
+type ContactRepository interface {
+	GetContactByUserID(ctx context.Context, userID int64) (*domain.Contact, error)
+}
+
+// LomsService определяет методы для получения информации о заказе.
+type LomsService interface {
+	GetOrderUserID(ctx context.Context, orderID int64) (int64, error)
+}
+
+// PreferencesProvider определяет методы для получения настроек уведомлений пользователя.
+type PreferencesProvider interface {
+	Get(ctx context.Context, userID int64) (*domain.NotificationPreferences, error)
+}
+
+// NotificationRenderer определяет методы для формирования уведомления по событию заказа.
+type NotificationRenderer interface {
+	Render(event *domain.OrderEvent) (*domain.Notification, error)
+}
+
+// NotificationChannel определяет канал доставки уведомлений.
+type NotificationChannel interface {
+	Name() string
+	Send(ctx context.Context, contact *domain.Contact, n *domain.Notification) error
+}
+
 // OrderEventService реализует обработку входящих событий заказа.
 type OrderEventService struct {
+	processedEvents ProcessedEventRepository
+	deferred        DeferredNotificationRepository
+	contacts        ContactRepository
+	lomsService     LomsService
+	preferences     PreferencesProvider
+	renderer        NotificationRenderer
+	channels        []NotificationChannel
+	now             func() time.Time
 }
 
 // NewOrderEventService создает новый экземпляр OrderEventConsumer.
-func NewOrderEventService() *OrderEventService {
-	return &OrderEventService{}
+func NewOrderEventService(
+	processedEvents ProcessedEventRepository,
+	deferred DeferredNotificationRepository,
+	contacts ContactRepository,
+	lomsService LomsService,
+	preferences PreferencesProvider,
+	renderer NotificationRenderer,
+	channels []NotificationChannel,
+) *OrderEventService {
+	return &OrderEventService{
+		processedEvents: processedEvents,
+		deferred:        deferred,
+		contacts:        contacts,
+		lomsService:     lomsService,
+		preferences:     preferences,
+		renderer:        renderer,
+		channels:        channels,
+		now:             time.Now,
+	}
+}
+
+// Process отправляет пользователю уведомление о смене статуса заказа во все каналы.
+// Повторно доставленные события пропускаются, а при повторной обработке
+// уведомление не отправляется в каналы, в которые оно уже было доставлено.
+// Если событие пришло в тихие часы пользователя, уведомление откладывается до их окончания.
+func (o *OrderEventService) Process(ctx context.Context, event *domain.OrderEvent) error {
+	key := event.DedupKey()
+
+	processed, err := o.processedEvents.IsProcessed(ctx, key)
+	if err != nil {
+		return fmt.Errorf("processedEvents.IsProcessed: %w", err)
+	}
+	if processed {
+		logger.Infow("Событие заказа уже обработано", "event_id", event.EventID, "order_id", event.OrderID, "status", event.Status)
+		return nil
+	}
+
+	logger.Infow("Событие заказа изменилось", "event_id", event.EventID, "order_id", event.OrderID, "status", event.Status, "moment", event.Moment)
+
+	_, err = o.notify(ctx, key, event)
+	if err != nil {
+		return err
+	}
+
+	err = o.processedEvents.MarkProcessed(ctx, key)
+	if err != nil {
+		return fmt.Errorf("processedEvents.MarkProcessed: %w", err)
+	}
+
+	return nil
+}
+
+// SendDeferred отправляет не более limit отложенных уведомлений, у которых закончились тихие часы.
+// Уведомления, которые не удалось отправить, остаются в хранилище и будут отправлены повторно.
+func (o *OrderEventService) SendDeferred(ctx context.Context, limit int) error {
+	due, err := o.deferred.GetDue(ctx, o.now(), limit)
+	if err != nil {
+		return fmt.Errorf("deferred.GetDue: %w", err)
+	}
+
+	var errs []error
+	for _, n := range due {
+		key := n.Event.DedupKey()
+
+		deferred, innerErr := o.notify(ctx, key, n.Event)
+		if innerErr != nil {
+			errs = append(errs, innerErr)
+			continue
+		}
+		if deferred {
+			continue
+		}
+
+		innerErr = o.deferred.Delete(ctx, key)
+		if innerErr != nil {
+			errs = append(errs, fmt.Errorf("deferred.Delete: %w", innerErr))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// notify отправляет уведомление о событии во все разрешенные пользователем каналы.
+// В тихие часы пользователя уведомление сохраняется до их окончания, и возвращается true.
+func (o *OrderEventService) notify(ctx context.Context, key string, event *domain.OrderEvent) (bool, error) {
+	notification, err := o.renderer.Render(event)
+	if errors.Is(err, domain.ErrTemplateNotFound) {
+		logger.Infow("Нет шаблона уведомления для статуса заказа", "order_id", event.OrderID, "status", event.Status)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("renderer.Render: %w", err)
+	}
+
+	userID, err := o.lomsService.GetOrderUserID(ctx, event.OrderID)
+	if err != nil {
+		return false, fmt.Errorf("lomsService.GetOrderUserID: %w", err)
+	}
+
+	prefs, err := o.preferences.Get(ctx, userID)
+	if err != nil {
+		return false, fmt.Errorf("preferences.Get: %w", err)
+	}
+	if !prefs.AllowsStatus(event.Status) {
+		logger.Infow("Пользователь отписан от уведомлений о статусе заказа", "user_id", userID, "order_id", event.OrderID, "status", event.Status)
+		return false, nil
+	}
+	if now := o.now(); prefs.IsQuietAt(now) {
+		sendAfter := prefs.QuietHours.EndAfter(now)
+		err = o.deferred.Save(ctx, &domain.DeferredNotification{Event: event, SendAfter: sendAfter})
+		if err != nil {
+			return false, fmt.Errorf("deferred.Save: %w", err)
+		}
+
+		logger.Infow("Уведомление отложено до окончания тихих часов пользователя", "user_id", userID, "order_id", event.OrderID, "status", event.Status, "send_after", sendAfter)
+		return true, nil
+	}
+
+	contact, err := o.contacts.GetContactByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrContactNotFound) {
+		logger.Warnw("Контакты пользователя не найдены", "user_id", userID, "order_id", event.OrderID)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("contacts.GetContactByUserID: %w", err)
+	}
+
+	var errs []error
+	for _, channel := range o.channels {
+		if !prefs.AllowsChannel(channel.Name()) {
+			metrics.IncNotificationCount(channel.Name(), "opted_out")
+			continue
+		}
+
+		err = o.sendToChannel(ctx, key, channel, contact, notification)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return false, errors.Join(errs...)
 }
 
-// Process логгирует событие о заказе в лог
-func (o *OrderEventService) Process(event *domain.OrderEvent) {
-	logger.Infow("Событие заказа изменилось", "order_id", event.OrderID, "status", event.Status, "moment", event.Moment)
+func (o *OrderEventService) sendToChannel(ctx context.Context, key string, channel NotificationChannel, contact *domain.Contact, n *domain.Notification) error {
+	channelKey := key + ":" + channel.Name()
+
+	sent, err := o.processedEvents.IsProcessed(ctx, channelKey)
+	if err != nil {
+		return fmt.Errorf("processedEvents.IsProcessed: %w", err)
+	}
+	if sent {
+		return nil
+	}
+
+	start := time.Now()
+	err = channel.Send(ctx, contact, n)
+	if errors.Is(err, domain.ErrNoChannelAddress) {
+		metrics.IncNotificationCount(channel.Name(), "skipped")
+		return nil
+	}
+	metrics.AddNotificationDurationHist(channel.Name(), err, time.Since(start))
+	if err != nil {
+		metrics.IncNotificationCount(channel.Name(), "failed")
+		logger.Errorw("Не удалось отправить уведомление", "err", err, "channel", channel.Name(), "user_id", contact.UserID, "order_id", n.OrderID)
+		return fmt.Errorf("%s channel.Send: %w", channel.Name(), err)
+	}
+	metrics.IncNotificationCount(channel.Name(), "sent")
+
+	err = o.processedEvents.MarkProcessed(ctx, channelKey)
+	if err != nil {
+		return fmt.Errorf("processedEvents.MarkProcessed: %w", err)
+	}
+
+	return nil
 }
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"route256/cart/pkg/logger"
+	"route256/notifier/internal/domain"
+	mock "route256/notifier/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+	"go.uber.org/zap"
+)
+
+func TestMain(m *testing.M) {
+	logger.InitLogger(&logger.Config{Level: zap.FatalLevel})
+	goleak.VerifyTestMain(m)
+}
+
+type testComponentOES struct {
+	processedMock   *mock.ProcessedEventRepositoryMock
+	deferredMock    *mock.DeferredNotificationRepositoryMock
+	contactsMock    *mock.ContactRepositoryMock
+	lomsMock        *mock.LomsServiceMock
+	preferencesMock *mock.PreferencesProviderMock
+	rendererMock    *mock.NotificationRendererMock
+	emailMock       *mock.NotificationChannelMock
+	webhookMock     *mock.NotificationChannelMock
+	service         *OrderEventService
+}
+
+func newTestComponentOES(t *testing.T) *testComponentOES {
+	mc := minimock.NewController(t)
+	tc := &testComponentOES{
+		processedMock:   mock.NewProcessedEventRepositoryMock(mc),
+		deferredMock:    mock.NewDeferredNotificationRepositoryMock(mc),
+		contactsMock:    mock.NewContactRepositoryMock(mc),
+		lomsMock:        mock.NewLomsServiceMock(mc),
+		preferencesMock: mock.NewPreferencesProviderMock(mc),
+		rendererMock:    mock.NewNotificationRendererMock(mc),
+		emailMock:       mock.NewNotificationChannelMock(mc),
+		webhookMock:     mock.NewNotificationChannelMock(mc),
+	}
+	tc.emailMock.NameMock.Optional().Return(domain.ChannelEmail)
+	tc.webhookMock.NameMock.Optional().Return(domain.ChannelWebhook)
+
+	tc.service = NewOrderEventService(
+		tc.processedMock,
+		tc.deferredMock,
+		tc.contactsMock,
+		tc.lomsMock,
+		tc.preferencesMock,
+		tc.rendererMock,
+		[]NotificationChannel{tc.emailMock, tc.webhookMock},
+	)
+
+	return tc
+}
+
+func TestOrderEventService_Process(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	event := &domain.OrderEvent{EventID: 7, OrderID: 10, Status: "paid", Moment: "2025-01-01T10:00:00Z"}
+	notification := &domain.Notification{OrderID: 10, Status: "paid", Subject: "subject", Body: "body"}
+	contact := &domain.Contact{UserID: 1, Email: "user@route256.local", WebhookURL: "http://hook"}
+
+	t.Run("уведомление отправляется во все каналы", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Expect(event).Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Expect(ctx, event.OrderID).Return(1, nil)
+		tc.preferencesMock.GetMock.Expect(ctx, 1).Return(domain.DefaultNotificationPreferences(1), nil)
+		tc.contactsMock.GetContactByUserIDMock.Expect(ctx, 1).Return(contact, nil)
+		tc.emailMock.SendMock.Expect(ctx, contact, notification).Return(nil)
+		tc.webhookMock.SendMock.Expect(ctx, contact, notification).Return(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:email").Then(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:webhook").Then(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7").Then(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+	})
+
+	t.Run("повторное событие пропускается", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+
+		tc.processedMock.IsProcessedMock.Expect(ctx, "7").Return(true, nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+	})
+
+	t.Run("при повторе уведомление не отправляется в уже успешный канал", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+
+		tc.processedMock.IsProcessedMock.When(ctx, "7").Then(false, nil)
+		tc.processedMock.IsProcessedMock.When(ctx, "7:email").Then(true, nil)
+		tc.processedMock.IsProcessedMock.When(ctx, "7:webhook").Then(false, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(domain.DefaultNotificationPreferences(1), nil)
+		tc.contactsMock.GetContactByUserIDMock.Return(contact, nil)
+		tc.webhookMock.SendMock.Return(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:webhook").Then(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7").Then(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+	})
+
+	t.Run("ошибка канала возвращается и событие не помечается обработанным", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		sendErr := errors.New("smtp unavailable")
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(domain.DefaultNotificationPreferences(1), nil)
+		tc.contactsMock.GetContactByUserIDMock.Return(contact, nil)
+		tc.emailMock.SendMock.Return(sendErr)
+		tc.webhookMock.SendMock.Return(nil)
+		tc.processedMock.MarkProcessedMock.Expect(ctx, "7:webhook").Return(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.ErrorIs(t, err, sendErr)
+	})
+
+	t.Run("пользователь отключил канал и статус", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		prefs := &domain.NotificationPreferences{UserID: 1, Channels: []string{domain.ChannelWebhook}, Statuses: []string{"paid"}}
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(prefs, nil)
+		tc.contactsMock.GetContactByUserIDMock.Return(contact, nil)
+		tc.webhookMock.SendMock.Return(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:webhook").Then(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7").Then(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+
+		cancelled := &domain.OrderEvent{EventID: 8, OrderID: 10, Status: "cancelled"}
+		tc.processedMock.MarkProcessedMock.When(ctx, "8").Then(nil)
+
+		err = tc.service.Process(ctx, cancelled)
+		require.NoError(t, err)
+	})
+
+	t.Run("в тихие часы уведомление откладывается до их окончания", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		tc.service.now = func() time.Time { return time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC) }
+		prefs := domain.DefaultNotificationPreferences(1)
+		prefs.QuietHours = &domain.QuietHours{Start: 22 * time.Hour, End: 8 * time.Hour, Timezone: "UTC"}
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(prefs, nil)
+		tc.deferredMock.SaveMock.Expect(ctx, &domain.DeferredNotification{
+			Event:     event,
+			SendAfter: time.Date(2025, 1, 2, 8, 0, 0, 0, time.UTC),
+		}).Return(nil)
+		tc.processedMock.MarkProcessedMock.Expect(ctx, "7").Return(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+	})
+
+	t.Run("событие не помечается обработанным, если не удалось отложить уведомление", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		tc.service.now = func() time.Time { return time.Date(2025, 1, 1, 23, 30, 0, 0, time.UTC) }
+		prefs := domain.DefaultNotificationPreferences(1)
+		prefs.QuietHours = &domain.QuietHours{Start: 22 * time.Hour, End: 8 * time.Hour, Timezone: "UTC"}
+		saveErr := errors.New("connection refused")
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(prefs, nil)
+		tc.deferredMock.SaveMock.Return(saveErr)
+
+		err := tc.service.Process(ctx, event)
+		require.ErrorIs(t, err, saveErr)
+	})
+
+	t.Run("нет шаблона для статуса", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.rendererMock.RenderMock.Return(nil, domain.ErrTemplateNotFound)
+		tc.processedMock.MarkProcessedMock.Expect(ctx, "7").Return(nil)
+
+		err := tc.service.Process(ctx, event)
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderEventService_SendDeferred(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2025, 1, 2, 8, 1, 0, 0, time.UTC)
+	event := &domain.OrderEvent{EventID: 7, OrderID: 10, Status: "paid", Moment: "2025-01-01T23:30:00Z"}
+	deferred := &domain.DeferredNotification{Event: event, SendAfter: time.Date(2025, 1, 2, 8, 0, 0, 0, time.UTC)}
+	notification := &domain.Notification{OrderID: 10, Status: "paid", Subject: "subject", Body: "body"}
+	contact := &domain.Contact{UserID: 1, Email: "user@route256.local", WebhookURL: "http://hook"}
+	prefs := domain.DefaultNotificationPreferences(1)
+	prefs.QuietHours = &domain.QuietHours{Start: 22 * time.Hour, End: 8 * time.Hour, Timezone: "UTC"}
+
+	t.Run("после тихих часов уведомление отправляется и удаляется из отложенных", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		tc.service.now = func() time.Time { return now }
+
+		tc.deferredMock.GetDueMock.Expect(ctx, now, 100).Return([]*domain.DeferredNotification{deferred}, nil)
+		tc.rendererMock.RenderMock.Expect(event).Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(prefs, nil)
+		tc.contactsMock.GetContactByUserIDMock.Return(contact, nil)
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.emailMock.SendMock.Return(nil)
+		tc.webhookMock.SendMock.Return(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:email").Then(nil)
+		tc.processedMock.MarkProcessedMock.When(ctx, "7:webhook").Then(nil)
+		tc.deferredMock.DeleteMock.Expect(ctx, "7").Return(nil)
+
+		err := tc.service.SendDeferred(ctx, 100)
+		require.NoError(t, err)
+	})
+
+	t.Run("если тихие часы продлили, уведомление откладывается снова", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		tc.service.now = func() time.Time { return now }
+		extended := domain.DefaultNotificationPreferences(1)
+		extended.QuietHours = &domain.QuietHours{Start: 22 * time.Hour, End: 10 * time.Hour, Timezone: "UTC"}
+
+		tc.deferredMock.GetDueMock.Return([]*domain.DeferredNotification{deferred}, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(extended, nil)
+		tc.deferredMock.SaveMock.Expect(ctx, &domain.DeferredNotification{
+			Event:     event,
+			SendAfter: time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC),
+		}).Return(nil)
+
+		err := tc.service.SendDeferred(ctx, 100)
+		require.NoError(t, err)
+	})
+
+	t.Run("при ошибке канала уведомление остается отложенным", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentOES(t)
+		tc.service.now = func() time.Time { return now }
+		sendErr := errors.New("smtp unavailable")
+
+		tc.deferredMock.GetDueMock.Return([]*domain.DeferredNotification{deferred}, nil)
+		tc.rendererMock.RenderMock.Return(notification, nil)
+		tc.lomsMock.GetOrderUserIDMock.Return(1, nil)
+		tc.preferencesMock.GetMock.Return(prefs, nil)
+		tc.contactsMock.GetContactByUserIDMock.Return(contact, nil)
+		tc.processedMock.IsProcessedMock.Return(false, nil)
+		tc.emailMock.SendMock.Return(sendErr)
+		tc.webhookMock.SendMock.Return(nil)
+		tc.processedMock.MarkProcessedMock.Expect(ctx, "7:webhook").Return(nil)
+
+		err := tc.service.SendDeferred(ctx, 100)
+		require.ErrorIs(t, err, sendErr)
+	})
+}
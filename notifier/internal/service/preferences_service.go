@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"route256/notifier/internal/domain"
+	"time"
+)
+
+// PreferencesRepository определяет методы для хранения настроек уведомлений пользователей.
+type PreferencesRepository interface {
+	GetByUserID(ctx context.Context, userID int64) (*domain.NotificationPreferences, error)
+	Upsert(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+// PreferencesService реализует управление настройками уведомлений пользователей.
+type PreferencesService struct {
+	repository PreferencesRepository
+}
+
+// NewPreferencesService создает новый экземпляр PreferencesService.
+func NewPreferencesService(repository PreferencesRepository) *PreferencesService {
+	return &PreferencesService{
+		repository: repository,
+	}
+}
+
+// Get возвращает настройки уведомлений пользователя.
+// Если пользователь их не задавал, возвращаются настройки по умолчанию.
+func (p *PreferencesService) Get(ctx context.Context, userID int64) (*domain.NotificationPreferences, error) {
+	prefs, err := p.repository.GetByUserID(ctx, userID)
+	if errors.Is(err, domain.ErrPreferencesNotFound) {
+		return domain.DefaultNotificationPreferences(userID), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("repository.GetByUserID: %w", err)
+	}
+
+	return prefs, nil
+}
+
+// Update сохраняет настройки уведомлений пользователя.
+func (p *PreferencesService) Update(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	if prefs.QuietHours != nil {
+		if prefs.QuietHours.Timezone == "" {
+			prefs.QuietHours.Timezone = time.UTC.String()
+		}
+
+		_, err := time.LoadLocation(prefs.QuietHours.Timezone)
+		if err != nil {
+			return domain.ErrTimezoneNotValid
+		}
+	}
+
+	err := p.repository.Upsert(ctx, prefs)
+	if err != nil {
+		return fmt.Errorf("repository.Upsert: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"route256/notifier/internal/domain"
+	mock "route256/notifier/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testComponentPS struct {
+	repoMock *mock.PreferencesRepositoryMock
+	service  *PreferencesService
+}
+
+func newTestComponentPS(t *testing.T) *testComponentPS {
+	mc := minimock.NewController(t)
+	repoMock := mock.NewPreferencesRepositoryMock(mc)
+
+	return &testComponentPS{
+		repoMock: repoMock,
+		service:  NewPreferencesService(repoMock),
+	}
+}
+
+func TestPreferencesService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("настройки по умолчанию, если пользователь их не задавал", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+		tc.repoMock.GetByUserIDMock.Expect(ctx, 1).Return(nil, domain.ErrPreferencesNotFound)
+
+		prefs, err := tc.service.Get(ctx, 1)
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.DefaultNotificationPreferences(1), prefs)
+	})
+
+	t.Run("сохранение настроек с часовым поясом по умолчанию", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+		prefs := &domain.NotificationPreferences{
+			UserID:     1,
+			Channels:   []string{domain.ChannelEmail},
+			Statuses:   []string{"paid"},
+			QuietHours: &domain.QuietHours{Start: 22 * time.Hour, End: 8 * time.Hour},
+		}
+		tc.repoMock.UpsertMock.Expect(ctx, prefs).Return(nil)
+
+		err := tc.service.Update(ctx, prefs)
+		require.NoError(t, err)
+
+		assert.Equal(t, "UTC", prefs.QuietHours.Timezone)
+	})
+
+	t.Run("неизвестный часовой пояс", func(t *testing.T) {
+		t.Parallel()
+
+		tc := newTestComponentPS(t)
+		prefs := &domain.NotificationPreferences{
+			UserID:     1,
+			QuietHours: &domain.QuietHours{Start: time.Hour, End: 2 * time.Hour, Timezone: "Mars/Olympus"},
+		}
+
+		err := tc.service.Update(ctx, prefs)
+		require.ErrorIs(t, err, domain.ErrTimezoneNotValid)
+	})
+}
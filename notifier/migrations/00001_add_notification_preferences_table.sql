@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notification_preferences (
+    user_id BIGINT PRIMARY KEY,
+    channels TEXT[] NOT NULL,
+    statuses TEXT[] NOT NULL,
+    quiet_hours_start TIME,
+    quiet_hours_end TIME,
+    timezone TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE notification_preferences;
+-- +goose StatementEnd
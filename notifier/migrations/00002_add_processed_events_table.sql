@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE processed_events (
+    key TEXT PRIMARY KEY,
+    processed_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE processed_events;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE deferred_notifications (
+    event_key TEXT PRIMARY KEY,
+    event_id BIGINT NOT NULL,
+    order_id BIGINT NOT NULL,
+    status TEXT NOT NULL,
+    moment TEXT NOT NULL,
+    send_after TIMESTAMP NOT NULL
+);
+
+CREATE INDEX deferred_notifications_send_after_idx ON deferred_notifications (send_after);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE deferred_notifications;
+-- +goose StatementEnd